@@ -520,19 +520,27 @@ resource "quismon_check" "mail_server" {
 
   regions = ["na-east-ewr"]
 
-  config_json = jsonencode({
-    smtp_host     = "mail.${var.primary_domain}"
-    smtp_port     = 587
-    smtp_username = "monitoring@${var.primary_domain}"
-    smtp_password = "CHANGE_ME"  # Use a variable in production
-    imap_host     = "mail.${var.primary_domain}"
-    imap_port     = 993
-    imap_username = "monitoring@${var.primary_domain}"
-    imap_password = "CHANGE_ME"
-    from_address  = "monitoring@${var.primary_domain}"
-    to_address    = "monitoring@${var.primary_domain}"
-    timeout       = 30
-  })
+  mail_deliverability {
+    from_address      = "monitoring@${var.primary_domain}"
+    to_address        = "monitoring@${var.primary_domain}"
+    timeout_seconds   = 30
+    require_auth_pass = ["spf", "dkim", "dmarc"]
+
+    smtp {
+      host     = "mail.${var.primary_domain}"
+      port     = 587
+      username = "monitoring@${var.primary_domain}"
+      password = "CHANGE_ME"  # Use a variable in production
+    }
+
+    imap {
+      host     = "mail.${var.primary_domain}"
+      port     = 993
+      username = "monitoring@${var.primary_domain}"
+      password = "CHANGE_ME"
+      use_tls  = true
+    }
+  }
 }
 
 # =============================================================================
@@ -98,6 +98,38 @@ resource "quismon_check" "dnssec_custom_ns" {
   })
 }
 
+# DNS Propagation Check - Fail when public resolvers disagree
+resource "quismon_check" "dns_propagation" {
+  name             = "Main Domain Propagation"
+  type             = "dns_propagation"
+  interval_seconds = 600
+  enabled          = true
+
+  regions = ["us-east-1"]
+
+  config = {
+    domain          = "example.com"
+    record_type     = "A"
+    expected_values = jsonencode(["93.184.216.34"])
+    quorum_percent  = 90
+  }
+}
+
+# Domain Registration Expiry Check
+resource "quismon_check" "domain_expiry" {
+  name             = "Domain Registration Expiry"
+  type             = "domain_expiry"
+  interval_seconds = 86400  # Check once a day
+  enabled          = true
+
+  regions = ["us-east-1"]
+
+  config = {
+    domain                = "example.com"
+    expiry_threshold_days = 30
+  }
+}
+
 # SSL Certificate Check
 resource "quismon_check" "ssl_cert" {
   name             = "API Certificate Expiry"
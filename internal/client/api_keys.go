@@ -0,0 +1,103 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// APIKey represents an organization API key
+type APIKey struct {
+	ID         string   `json:"id"`
+	OrgID      string   `json:"org_id"`
+	Name       string   `json:"name"`
+	Key        string   `json:"key,omitempty"` // Only returned once, on creation
+	KeyPrefix  string   `json:"key_prefix"`
+	Scopes     []string `json:"scopes"`
+	AllowedIPs []string `json:"allowed_ips,omitempty"`
+	ExpiresAt  *string  `json:"expires_at,omitempty"`
+	LastUsedAt *string  `json:"last_used_at,omitempty"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+}
+
+// CreateAPIKeyRequest represents a request to create an API key
+type CreateAPIKeyRequest struct {
+	Name       string   `json:"name"`
+	Scopes     []string `json:"scopes"`
+	AllowedIPs []string `json:"allowed_ips,omitempty"`
+	ExpiresAt  *string  `json:"expires_at,omitempty"`
+}
+
+// UpdateAPIKeyRequest represents a request to update an API key.
+// Scopes and expiry are immutable; changing them requires a new key.
+type UpdateAPIKeyRequest struct {
+	Name       *string   `json:"name,omitempty"`
+	AllowedIPs *[]string `json:"allowed_ips,omitempty"`
+}
+
+// ListAPIKeys retrieves all API keys for the organization
+func (c *Client) ListAPIKeys() ([]APIKey, error) {
+	data, err := c.DoRequest(http.MethodGet, "/v1/api-keys", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []APIKey
+	if err := UnmarshalAPIResponse(data, &keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// GetAPIKey retrieves a specific API key by ID. The secret key value is not returned.
+func (c *Client) GetAPIKey(id string) (*APIKey, error) {
+	data, err := c.DoRequest(http.MethodGet, fmt.Sprintf("/v1/api-keys/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key APIKey
+	if err := UnmarshalAPIResponse(data, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// CreateAPIKey creates a new API key. The returned Key field holds the secret,
+// which cannot be retrieved again.
+func (c *Client) CreateAPIKey(req CreateAPIKeyRequest) (*APIKey, error) {
+	data, err := c.DoRequest(http.MethodPost, "/v1/api-keys", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var key APIKey
+	if err := UnmarshalAPIResponse(data, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// UpdateAPIKey updates an existing API key
+func (c *Client) UpdateAPIKey(id string, req UpdateAPIKeyRequest) (*APIKey, error) {
+	data, err := c.DoRequest(http.MethodPut, fmt.Sprintf("/v1/api-keys/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var key APIKey
+	if err := UnmarshalAPIResponse(data, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// DeleteAPIKey revokes an API key
+func (c *Client) DeleteAPIKey(id string) error {
+	_, err := c.DoRequest(http.MethodDelete, fmt.Sprintf("/v1/api-keys/%s", id), nil)
+	return err
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PauseCheckRequest represents a request to pause a check temporarily
+type PauseCheckRequest struct {
+	Until string `json:"until"` // RFC3339 timestamp at which the check resumes by itself
+}
+
+// IsPaused reports whether the check is paused at the given time. A paused check is
+// still enabled; it simply does not run or alert until PausedUntil.
+func (c *Check) IsPaused(now time.Time) bool {
+	if c.PausedUntil == nil {
+		return false
+	}
+	until, err := time.Parse(time.RFC3339, *c.PausedUntil)
+	if err != nil {
+		return false
+	}
+	return now.Before(until)
+}
+
+// PauseCheck pauses a check until the given RFC3339 timestamp
+func (c *Client) PauseCheck(id, until string) (*Check, error) {
+	data, err := c.DoRequest(http.MethodPost, fmt.Sprintf("/v1/checks/%s/pause", id), PauseCheckRequest{Until: until})
+	if err != nil {
+		return nil, err
+	}
+
+	var check Check
+	if err := UnmarshalAPIResponse(data, &check); err != nil {
+		return nil, err
+	}
+
+	return &check, nil
+}
+
+// ResumeCheck ends a temporary pause before its paused_until time
+func (c *Client) ResumeCheck(id string) (*Check, error) {
+	data, err := c.DoRequest(http.MethodPost, fmt.Sprintf("/v1/checks/%s/resume", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var check Check
+	if err := UnmarshalAPIResponse(data, &check); err != nil {
+		return nil, err
+	}
+
+	return &check, nil
+}
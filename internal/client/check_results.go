@@ -0,0 +1,105 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CheckResult represents a single run of a check in one region
+type CheckResult struct {
+	ID            string  `json:"id"`
+	CheckID       string  `json:"check_id"`
+	Region        string  `json:"region"`
+	Status        string  `json:"status"` // success, failure, timeout, or dependency_failed
+	LatencyMs     float64 `json:"latency_ms"`
+	Error         string  `json:"error,omitempty"`
+	FailingStep   string  `json:"failing_step,omitempty"`   // Multistep and browser checks only: name of the step that failed
+	ScreenshotURL string  `json:"screenshot_url,omitempty"` // Browser checks only: screenshot taken when the run failed
+	CheckedAt     string  `json:"checked_at"`
+
+	// smtp-imap checks only: time from SMTP submission to arrival in the IMAP
+	// mailbox, and the spf, dkim and dmarc results of the Authentication-Results header
+	DeliveryLatencyMs     *float64          `json:"delivery_latency_ms,omitempty"`
+	AuthenticationResults map[string]string `json:"authentication_results,omitempty"`
+}
+
+// CheckUptime represents aggregated uptime and latency of a check over a window
+type CheckUptime struct {
+	CheckID       string              `json:"check_id"`
+	WindowHours   int                 `json:"window_hours"`
+	UptimePercent float64             `json:"uptime_percent"`
+	TotalRuns     int                 `json:"total_runs"`
+	FailedRuns    int                 `json:"failed_runs"`
+	LatencyP50Ms  float64             `json:"latency_p50_ms"`
+	LatencyP95Ms  float64             `json:"latency_p95_ms"`
+	LatencyP99Ms  float64             `json:"latency_p99_ms"`
+	Regions       []CheckRegionUptime `json:"regions"`
+}
+
+// CheckRegionUptime represents aggregated uptime and latency of a check in one region
+type CheckRegionUptime struct {
+	Region        string  `json:"region"`
+	UptimePercent float64 `json:"uptime_percent"`
+	TotalRuns     int     `json:"total_runs"`
+	FailedRuns    int     `json:"failed_runs"`
+	LatencyP50Ms  float64 `json:"latency_p50_ms"`
+	LatencyP95Ms  float64 `json:"latency_p95_ms"`
+	LatencyP99Ms  float64 `json:"latency_p99_ms"`
+}
+
+// CheckResultsOptions filters the results returned by GetCheckResults
+type CheckResultsOptions struct {
+	Regions        []string // Only results from these regions
+	LimitPerRegion int      // Most recent results to return per region; 0 uses the API default
+	Since          string   // RFC3339 timestamp; only results after it
+}
+
+// GetCheckResults retrieves recent results of a check, newest first
+func (c *Client) GetCheckResults(checkID string, opts CheckResultsOptions) ([]CheckResult, error) {
+	query := url.Values{}
+	for _, region := range opts.Regions {
+		query.Add("region", region)
+	}
+	if opts.LimitPerRegion > 0 {
+		query.Set("limit_per_region", strconv.Itoa(opts.LimitPerRegion))
+	}
+	if opts.Since != "" {
+		query.Set("since", opts.Since)
+	}
+
+	path := fmt.Sprintf("/v1/checks/%s/results", checkID)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	data, err := c.DoRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []CheckResult
+	if err := UnmarshalAPIResponse(data, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetCheckUptime retrieves aggregated uptime and latency percentiles of a check
+// over the last windowHours hours
+func (c *Client) GetCheckUptime(checkID string, windowHours int) (*CheckUptime, error) {
+	path := fmt.Sprintf("/v1/checks/%s/uptime?window_hours=%d", checkID, windowHours)
+	data, err := c.DoRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var uptime CheckUptime
+	if err := UnmarshalAPIResponse(data, &uptime); err != nil {
+		return nil, err
+	}
+
+	return &uptime, nil
+}
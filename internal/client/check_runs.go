@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// RunCheckRequest represents a request to run a check on demand
+type RunCheckRequest struct {
+	Regions []string `json:"regions,omitempty"` // Regions to run in; empty runs in all of the check's regions
+}
+
+// RunCheck runs a check immediately instead of waiting for its next interval and
+// returns the result of the run in each region. The call blocks until every
+// region has reported, which can take longer than HTTPClient's timeout, so ctx
+// must carry a deadline.
+func (c *Client) RunCheck(ctx context.Context, checkID string, regions []string) ([]CheckResult, error) {
+	data, err := c.doLongRequest(ctx, http.MethodPost, fmt.Sprintf("/v1/checks/%s/run", checkID), RunCheckRequest{Regions: regions})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []CheckResult
+	if err := UnmarshalAPIResponse(data, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
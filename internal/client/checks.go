@@ -19,15 +19,35 @@ type Check struct {
 	Inverted            bool                   `json:"inverted"` // Alert on success instead of failure
 	SimultaneousRegions bool                   `json:"simultaneous_regions"`
 	RecheckOnFailure    bool                   `json:"recheck_on_failure"`
-	ShowOnStatusPage    bool                   `json:"show_on_status_page"` // Contribute to public status page
+	ShowOnStatusPage    bool                   `json:"show_on_status_page"`             // Contribute to public status page
 	ExpiresAfterSeconds *int                   `json:"expires_after_seconds,omitempty"` // Check auto-deletes after this many seconds
-	DependsOn           []string               `json:"depends_on,omitempty"` // Check IDs that must be healthy before this check runs
+	DependsOn           []string               `json:"depends_on,omitempty"`            // Check IDs that must be healthy before this check runs
+	Tags                []string               `json:"tags,omitempty"`
+	PingURL             string                 `json:"ping_url,omitempty"`     // Heartbeat checks only: URL the monitored job pings
+	PausedUntil         *string                `json:"paused_until,omitempty"` // Set while the check is temporarily paused
+	Schedule            *CheckSchedule         `json:"schedule,omitempty"`     // Restricts when the check runs at interval_seconds
 	HealthStatus        string                 `json:"health_status,omitempty"`
 	LastChecked         *string                `json:"last_checked,omitempty"`
 	CreatedAt           string                 `json:"created_at"`
 	UpdatedAt           string                 `json:"updated_at"`
 }
 
+// CheckSchedule restricts the hours in which a check runs at its regular interval.
+// Outside the windows the check runs every OffHoursIntervalSeconds, or not at all
+// if that is nil.
+type CheckSchedule struct {
+	Timezone                string                `json:"timezone"` // IANA time zone the windows are in
+	Windows                 []CheckScheduleWindow `json:"windows"`
+	OffHoursIntervalSeconds *int                  `json:"off_hours_interval_seconds,omitempty"`
+}
+
+// CheckScheduleWindow is a daily time window on the given weekdays
+type CheckScheduleWindow struct {
+	Days  []string `json:"days"`  // mon, tue, wed, thu, fri, sat, sun
+	Start string   `json:"start"` // HH:MM, inclusive
+	End   string   `json:"end"`   // HH:MM, exclusive; 24:00 is the end of the day
+}
+
 // CreateCheckRequest represents a request to create a check
 type CreateCheckRequest struct {
 	Name                string                 `json:"name"`
@@ -39,9 +59,11 @@ type CreateCheckRequest struct {
 	Inverted            *bool                  `json:"inverted,omitempty"` // Alert on success instead of failure
 	SimultaneousRegions *bool                  `json:"simultaneous_regions,omitempty"`
 	RecheckOnFailure    *bool                  `json:"recheck_on_failure,omitempty"`
-	ShowOnStatusPage    *bool                  `json:"show_on_status_page,omitempty"` // Contribute to public status page
+	ShowOnStatusPage    *bool                  `json:"show_on_status_page,omitempty"`   // Contribute to public status page
 	ExpiresAfterSeconds *int                   `json:"expires_after_seconds,omitempty"` // Check auto-deletes after this many seconds
-	DependsOn           []string               `json:"depends_on,omitempty"` // Check IDs that must be healthy before this check runs
+	DependsOn           []string               `json:"depends_on,omitempty"`            // Check IDs that must be healthy before this check runs
+	Tags                []string               `json:"tags,omitempty"`
+	Schedule            *CheckSchedule         `json:"schedule,omitempty"`
 }
 
 // UpdateCheckRequest represents a request to update a check
@@ -55,9 +77,11 @@ type UpdateCheckRequest struct {
 	Inverted            *bool                   `json:"inverted,omitempty"` // Alert on success instead of failure
 	SimultaneousRegions *bool                   `json:"simultaneous_regions,omitempty"`
 	RecheckOnFailure    *bool                   `json:"recheck_on_failure,omitempty"`
-	ShowOnStatusPage    *bool                   `json:"show_on_status_page,omitempty"` // Contribute to public status page
+	ShowOnStatusPage    *bool                   `json:"show_on_status_page,omitempty"`   // Contribute to public status page
 	ExpiresAfterSeconds *int                    `json:"expires_after_seconds,omitempty"` // Check auto-deletes after this many seconds
-	DependsOn           *[]string               `json:"depends_on,omitempty"` // Check IDs that must be healthy before this check runs
+	DependsOn           *[]string               `json:"depends_on,omitempty"`            // Check IDs that must be healthy before this check runs
+	Tags                *[]string               `json:"tags,omitempty"`
+	Schedule            *CheckSchedule          `json:"schedule"` // Always sent; nil removes the schedule
 }
 
 // ListChecks retrieves all checks
@@ -2,7 +2,9 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,6 +17,10 @@ type Client struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+
+	// Organization is fetched once when the provider is configured so that
+	// resources can check tier limits at plan time. Nil if it could not be read.
+	Organization *Organization
 }
 
 // APIResponse represents the standard API response wrapper
@@ -24,6 +30,22 @@ type APIResponse struct {
 	Meta  map[string]string `json:"meta,omitempty"`
 }
 
+// APIError is returned for error responses from the API
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (%d): %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is an API error for a resource that does not exist
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
 // New creates a new Quismon API client
 func New(baseURL, apiKey string) (*Client, error) {
 	if baseURL == "" {
@@ -43,6 +65,25 @@ func New(baseURL, apiKey string) (*Client, error) {
 
 // DoRequest performs an HTTP request with authentication
 func (c *Client) DoRequest(method, path string, body interface{}) ([]byte, error) {
+	return c.DoRequestWithContext(context.Background(), method, path, body)
+}
+
+// DoRequestWithContext performs an HTTP request with authentication that is
+// cancelled when ctx is done
+func (c *Client) DoRequestWithContext(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, c.HTTPClient, method, path, body)
+}
+
+// doLongRequest performs an HTTP request like DoRequestWithContext but without
+// HTTPClient's timeout, for calls that block server-side for longer than it.
+// ctx must carry a deadline.
+func (c *Client) doLongRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	httpClient := *c.HTTPClient
+	httpClient.Timeout = 0
+	return c.doRequest(ctx, &httpClient, method, path, body)
+}
+
+func (c *Client) doRequest(ctx context.Context, httpClient *http.Client, method, path string, body interface{}) ([]byte, error) {
 	var reqBody io.Reader
 	if body != nil {
 		jsonData, err := json.Marshal(body)
@@ -52,7 +93,7 @@ func (c *Client) DoRequest(method, path string, body interface{}) ([]byte, error
 		reqBody = bytes.NewBuffer(jsonData)
 	}
 
-	req, err := http.NewRequest(method, c.BaseURL+path, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -70,7 +111,7 @@ func (c *Client) DoRequest(method, path string, body interface{}) ([]byte, error
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", "terraform-provider-quismon/1.0")
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -85,9 +126,9 @@ func (c *Client) DoRequest(method, path string, body interface{}) ([]byte, error
 	if resp.StatusCode >= 400 {
 		var apiResp APIResponse
 		if err := json.Unmarshal(bodyBytes, &apiResp); err == nil && apiResp.Error != nil {
-			return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, *apiResp.Error)
+			return nil, &APIError{StatusCode: resp.StatusCode, Message: *apiResp.Error}
 		}
-		return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, string(bodyBytes))
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: string(bodyBytes)}
 	}
 
 	return bodyBytes, nil
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Export sink event types
+const (
+	ExportEventCheckResult     = "check_result"     // Every check run in every region
+	ExportEventAlertTransition = "alert_transition" // An alert rule firing or resolving
+)
+
+// ExportSink ships raw check results and alert state transitions to external storage
+// or a log pipeline as events. Exactly one of the destination fields matching Type
+// is set. Secrets (S3 secret key, Splunk token, Loki password, webhook header
+// values) are write-only and never returned; SecretHash changes whenever they do.
+type ExportSink struct {
+	ID         string             `json:"id"`
+	OrgID      string             `json:"org_id"`
+	Name       string             `json:"name"`
+	Type       string             `json:"type"` // s3, splunk_hec, loki, or webhook
+	Enabled    bool               `json:"enabled"`
+	EventTypes []string           `json:"event_types"`
+	CheckTags  []string           `json:"check_tags,omitempty"` // Only export events of checks with one of these tags
+	S3         *ExportSinkS3      `json:"s3,omitempty"`
+	SplunkHEC  *ExportSinkSplunk  `json:"splunk_hec,omitempty"`
+	Loki       *ExportSinkLoki    `json:"loki,omitempty"`
+	Webhook    *ExportSinkWebhook `json:"webhook,omitempty"`
+	SecretHash string             `json:"secret_hash,omitempty"` // Hash of the destination's secrets for drift detection
+	CreatedAt  string             `json:"created_at"`
+	UpdatedAt  string             `json:"updated_at"`
+}
+
+// ExportSinkS3 writes gzipped NDJSON batches to an S3-compatible bucket
+type ExportSinkS3 struct {
+	Bucket          string `json:"bucket"`
+	Region          string `json:"region,omitempty"`
+	Endpoint        string `json:"endpoint,omitempty"` // For S3-compatible storage such as MinIO or R2
+	Prefix          string `json:"prefix,omitempty"`
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key,omitempty"`
+	ForcePathStyle  bool   `json:"force_path_style"`
+}
+
+// ExportSinkSplunk sends events to a Splunk HTTP Event Collector
+type ExportSinkSplunk struct {
+	URL        string `json:"url"`
+	Token      string `json:"token,omitempty"`
+	Index      string `json:"index,omitempty"`
+	SourceType string `json:"source_type,omitempty"`
+}
+
+// ExportSinkLoki pushes events to Grafana Loki as log lines
+type ExportSinkLoki struct {
+	URL      string            `json:"url"`
+	TenantID string            `json:"tenant_id,omitempty"`
+	Username string            `json:"username,omitempty"`
+	Password string            `json:"password,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"` // Static stream labels
+}
+
+// ExportSinkWebhook POSTs batches of events as NDJSON
+type ExportSinkWebhook struct {
+	URL       string            `json:"url"`
+	Headers   map[string]string `json:"headers,omitempty"` // Values are returned redacted
+	BatchSize int               `json:"batch_size,omitempty"`
+}
+
+// CreateExportSinkRequest represents a request to create an export sink
+type CreateExportSinkRequest struct {
+	Name       string             `json:"name"`
+	Type       string             `json:"type"`
+	Enabled    bool               `json:"enabled"`
+	EventTypes []string           `json:"event_types"`
+	CheckTags  []string           `json:"check_tags,omitempty"`
+	S3         *ExportSinkS3      `json:"s3,omitempty"`
+	SplunkHEC  *ExportSinkSplunk  `json:"splunk_hec,omitempty"`
+	Loki       *ExportSinkLoki    `json:"loki,omitempty"`
+	Webhook    *ExportSinkWebhook `json:"webhook,omitempty"`
+}
+
+// UpdateExportSinkRequest represents a request to update an export sink. The
+// destination is replaced as a whole.
+type UpdateExportSinkRequest struct {
+	Name       *string            `json:"name,omitempty"`
+	Enabled    *bool              `json:"enabled,omitempty"`
+	EventTypes *[]string          `json:"event_types,omitempty"`
+	CheckTags  *[]string          `json:"check_tags,omitempty"`
+	S3         *ExportSinkS3      `json:"s3,omitempty"`
+	SplunkHEC  *ExportSinkSplunk  `json:"splunk_hec,omitempty"`
+	Loki       *ExportSinkLoki    `json:"loki,omitempty"`
+	Webhook    *ExportSinkWebhook `json:"webhook,omitempty"`
+}
+
+// ListExportSinks retrieves all export sinks
+func (c *Client) ListExportSinks() ([]ExportSink, error) {
+	data, err := c.DoRequest(http.MethodGet, "/v1/export-sinks", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sinks []ExportSink
+	if err := UnmarshalAPIResponse(data, &sinks); err != nil {
+		return nil, err
+	}
+
+	return sinks, nil
+}
+
+// GetExportSink retrieves a specific export sink by ID
+func (c *Client) GetExportSink(id string) (*ExportSink, error) {
+	data, err := c.DoRequest(http.MethodGet, fmt.Sprintf("/v1/export-sinks/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sink ExportSink
+	if err := UnmarshalAPIResponse(data, &sink); err != nil {
+		return nil, err
+	}
+
+	return &sink, nil
+}
+
+// CreateExportSink creates a new export sink
+func (c *Client) CreateExportSink(req CreateExportSinkRequest) (*ExportSink, error) {
+	data, err := c.DoRequest(http.MethodPost, "/v1/export-sinks", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var sink ExportSink
+	if err := UnmarshalAPIResponse(data, &sink); err != nil {
+		return nil, err
+	}
+
+	return &sink, nil
+}
+
+// UpdateExportSink updates an existing export sink
+func (c *Client) UpdateExportSink(id string, req UpdateExportSinkRequest) (*ExportSink, error) {
+	data, err := c.DoRequest(http.MethodPut, fmt.Sprintf("/v1/export-sinks/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var sink ExportSink
+	if err := UnmarshalAPIResponse(data, &sink); err != nil {
+		return nil, err
+	}
+
+	return &sink, nil
+}
+
+// DeleteExportSink deletes an export sink
+func (c *Client) DeleteExportSink(id string) error {
+	_, err := c.DoRequest(http.MethodDelete, fmt.Sprintf("/v1/export-sinks/%s", id), nil)
+	return err
+}
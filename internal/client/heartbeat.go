@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Heartbeat signals accepted by SendHeartbeat
+const (
+	HeartbeatSuccess = ""      // The job completed successfully
+	HeartbeatStart   = "start" // The job started; lets Quismon measure run duration
+	HeartbeatFail    = "fail"  // The job failed; alerts immediately instead of waiting for the deadline
+)
+
+// SendHeartbeat pings a heartbeat check's ping URL. The ping URL carries its own
+// token, so no API key is sent and the call works from any machine running the job.
+// The request is cancelled when ctx is done.
+func (c *Client) SendHeartbeat(ctx context.Context, pingURL, signal string) error {
+	if pingURL == "" {
+		return fmt.Errorf("ping URL is required")
+	}
+
+	url := strings.TrimSuffix(pingURL, "/")
+	switch signal {
+	case HeartbeatSuccess:
+	case HeartbeatStart, HeartbeatFail:
+		url += "/" + signal
+	default:
+		return fmt.Errorf("unknown heartbeat signal %q (expected %q, %q, or %q)", signal, HeartbeatSuccess, HeartbeatStart, HeartbeatFail)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", "terraform-provider-quismon/1.0")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send heartbeat: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("heartbeat rejected (%d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
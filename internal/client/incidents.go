@@ -0,0 +1,171 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Incident represents an incident shown on a status page
+type Incident struct {
+	ID                   string           `json:"id"`
+	OrgID                string           `json:"org_id"`
+	StatusPageID         string           `json:"status_page_id"`
+	Title                string           `json:"title"`
+	Kind                 string           `json:"kind"`   // announced or scheduled
+	Status               string           `json:"status"` // investigating, identified, monitoring, resolved, scheduled, in_progress, or completed
+	Impact               string           `json:"impact"` // none, minor, major, or critical
+	AffectedComponentIDs []string         `json:"affected_component_ids"`
+	ScheduledStart       *string          `json:"scheduled_start,omitempty"`
+	ScheduledEnd         *string          `json:"scheduled_end,omitempty"`
+	StartedAt            *string          `json:"started_at,omitempty"`
+	ResolvedAt           *string          `json:"resolved_at,omitempty"`
+	Updates              []IncidentUpdate `json:"updates"`
+	CreatedAt            string           `json:"created_at"`
+	UpdatedAt            string           `json:"updated_at"`
+}
+
+// IncidentUpdate represents a progress update posted on an incident
+type IncidentUpdate struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+	CreatedAt string `json:"created_at"`
+}
+
+// IsOpen reports whether the incident is still ongoing or upcoming
+func (i *Incident) IsOpen() bool {
+	return i.Status != "resolved" && i.Status != "completed"
+}
+
+// CreateIncidentRequest represents a request to create an incident
+type CreateIncidentRequest struct {
+	StatusPageID         string   `json:"status_page_id"`
+	Title                string   `json:"title"`
+	Kind                 string   `json:"kind"`
+	Status               string   `json:"status"`
+	Impact               string   `json:"impact"`
+	AffectedComponentIDs []string `json:"affected_component_ids"`
+	ScheduledStart       *string  `json:"scheduled_start,omitempty"`
+	ScheduledEnd         *string  `json:"scheduled_end,omitempty"`
+}
+
+// UpdateIncidentRequest represents a request to update an incident
+type UpdateIncidentRequest struct {
+	Title                *string   `json:"title,omitempty"`
+	Status               *string   `json:"status,omitempty"`
+	Impact               *string   `json:"impact,omitempty"`
+	AffectedComponentIDs *[]string `json:"affected_component_ids,omitempty"`
+	ScheduledStart       *string   `json:"scheduled_start,omitempty"`
+	ScheduledEnd         *string   `json:"scheduled_end,omitempty"`
+}
+
+// CreateIncidentUpdateRequest represents a request to post an update on an incident
+type CreateIncidentUpdateRequest struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// ListIncidentsOptions filters the incidents returned by ListIncidents
+type ListIncidentsOptions struct {
+	StatusPageID string // Only incidents on this status page
+	State        string // "open", "closed", or empty for both
+	Since        string // RFC3339 timestamp; only incidents started or scheduled after it
+}
+
+// ListIncidents retrieves incidents, newest first
+func (c *Client) ListIncidents(opts ListIncidentsOptions) ([]Incident, error) {
+	query := url.Values{}
+	if opts.StatusPageID != "" {
+		query.Set("status_page_id", opts.StatusPageID)
+	}
+	if opts.State != "" {
+		query.Set("state", opts.State)
+	}
+	if opts.Since != "" {
+		query.Set("since", opts.Since)
+	}
+
+	path := "/v1/incidents"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	data, err := c.DoRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var incidents []Incident
+	if err := UnmarshalAPIResponse(data, &incidents); err != nil {
+		return nil, err
+	}
+
+	return incidents, nil
+}
+
+// GetIncident retrieves a specific incident by ID
+func (c *Client) GetIncident(id string) (*Incident, error) {
+	data, err := c.DoRequest(http.MethodGet, fmt.Sprintf("/v1/incidents/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var incident Incident
+	if err := UnmarshalAPIResponse(data, &incident); err != nil {
+		return nil, err
+	}
+
+	return &incident, nil
+}
+
+// CreateIncident creates a new incident
+func (c *Client) CreateIncident(req CreateIncidentRequest) (*Incident, error) {
+	data, err := c.DoRequest(http.MethodPost, "/v1/incidents", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var incident Incident
+	if err := UnmarshalAPIResponse(data, &incident); err != nil {
+		return nil, err
+	}
+
+	return &incident, nil
+}
+
+// UpdateIncident updates an existing incident
+func (c *Client) UpdateIncident(id string, req UpdateIncidentRequest) (*Incident, error) {
+	data, err := c.DoRequest(http.MethodPut, fmt.Sprintf("/v1/incidents/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var incident Incident
+	if err := UnmarshalAPIResponse(data, &incident); err != nil {
+		return nil, err
+	}
+
+	return &incident, nil
+}
+
+// AddIncidentUpdate posts a progress update on an incident and sets its status
+func (c *Client) AddIncidentUpdate(id string, req CreateIncidentUpdateRequest) (*IncidentUpdate, error) {
+	data, err := c.DoRequest(http.MethodPost, fmt.Sprintf("/v1/incidents/%s/updates", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var update IncidentUpdate
+	if err := UnmarshalAPIResponse(data, &update); err != nil {
+		return nil, err
+	}
+
+	return &update, nil
+}
+
+// DeleteIncident deletes an incident
+func (c *Client) DeleteIncident(id string) error {
+	_, err := c.DoRequest(http.MethodDelete, fmt.Sprintf("/v1/incidents/%s", id), nil)
+	return err
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Organization represents the organization that owns the API key
+type Organization struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name"`
+	Tier                 string             `json:"tier"`
+	Email                string             `json:"email"`
+	EmailVerified        bool               `json:"email_verified"`
+	VerificationRequired bool               `json:"verification_required"`
+	DefaultTimeZone      string             `json:"default_time_zone,omitempty"`
+	DefaultRegions       []string           `json:"default_regions,omitempty"`
+	BillingEmail         string             `json:"billing_email,omitempty"`
+	Limits               OrganizationLimits `json:"limits"`
+	Usage                OrganizationUsage  `json:"usage"`
+	CreatedAt            string             `json:"created_at"`
+	UpdatedAt            string             `json:"updated_at"`
+}
+
+// OrganizationLimits describes what the organization's tier allows.
+// A zero value for a numeric limit means unlimited.
+type OrganizationLimits struct {
+	MaxChecks          int      `json:"max_checks"`
+	MinIntervalSeconds int      `json:"min_interval_seconds"`
+	MaxRegionsPerCheck int      `json:"max_regions_per_check"`
+	ChecksPerHour      int      `json:"checks_per_hour"`               // Check executions per hour across the org
+	Features           []string `json:"features"`                      // Paid features enabled for the tier, e.g. "otlp"
+	AllowedCheckTypes  []string `json:"allowed_check_types,omitempty"` // Empty means all check types are allowed
+}
+
+// OrganizationUsage describes how much of the tier quota is in use
+type OrganizationUsage struct {
+	CheckCount int `json:"check_count"`
+}
+
+// HasFeature reports whether the tier includes the named feature
+func (l OrganizationLimits) HasFeature(feature string) bool {
+	for _, f := range l.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsCheckType reports whether the tier allows creating checks of the given type
+func (l OrganizationLimits) AllowsCheckType(checkType string) bool {
+	if len(l.AllowedCheckTypes) == 0 {
+		return true
+	}
+	for _, t := range l.AllowedCheckTypes {
+		if t == checkType {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateOrganizationRequest represents a request to update the organization
+type UpdateOrganizationRequest struct {
+	Name            *string   `json:"name,omitempty"`
+	DefaultTimeZone *string   `json:"default_time_zone,omitempty"`
+	DefaultRegions  *[]string `json:"default_regions,omitempty"`
+	BillingEmail    *string   `json:"billing_email,omitempty"`
+}
+
+// GetOrganization retrieves the organization the API key belongs to
+func (c *Client) GetOrganization() (*Organization, error) {
+	data, err := c.DoRequest(http.MethodGet, "/v1/org", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var org Organization
+	if err := UnmarshalAPIResponse(data, &org); err != nil {
+		return nil, err
+	}
+
+	return &org, nil
+}
+
+// UpdateOrganization updates the organization the API key belongs to
+func (c *Client) UpdateOrganization(req UpdateOrganizationRequest) (*Organization, error) {
+	data, err := c.DoRequest(http.MethodPut, "/v1/org", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var org Organization
+	if err := UnmarshalAPIResponse(data, &org); err != nil {
+		return nil, err
+	}
+
+	return &org, nil
+}
+
+// DeleteOrganization permanently deletes the organization the API key belongs to,
+// including all of its checks, alert rules and notification channels
+func (c *Client) DeleteOrganization() error {
+	_, err := c.DoRequest(http.MethodDelete, "/v1/org", nil)
+	return err
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"net/http"
+)
+
+// OTLP export protocols
+const (
+	OTLPProtocolHTTPProtobuf = "http/protobuf"
+	OTLPProtocolGRPC         = "grpc"
+)
+
+// OTLPMetricFamilies lists the metric families that can be selected for export
+var OTLPMetricFamilies = []string{"check_status", "check_latency", "uptime", "certificate_expiry", "alerts", "slo"}
+
+// OTLPConfig represents the organization's OTLP metrics export configuration.
+// Header values and the client key are write-only: headers come back with
+// redacted values, and HeadersHash changes whenever they are modified.
+type OTLPConfig struct {
+	Enabled               bool              `json:"otlp_enabled"`
+	Endpoint              *string           `json:"otlp_endpoint"`
+	Protocol              string            `json:"otlp_protocol,omitempty"`    // http/protobuf or grpc
+	Compression           string            `json:"otlp_compression,omitempty"` // none or gzip
+	Headers               map[string]string `json:"otlp_headers"`
+	HeadersHash           string            `json:"otlp_headers_hash,omitempty"` // Hash of header names and values for drift detection
+	ExportIntervalSeconds *int              `json:"otlp_export_interval_seconds"`
+	ResourceAttributes    map[string]string `json:"otlp_resource_attributes,omitempty"`
+	MetricFamilies        []string          `json:"otlp_metric_families,omitempty"`    // Empty exports all families
+	ClientCertificate     *string           `json:"otlp_client_certificate,omitempty"` // PEM, for mTLS
+	CACertificate         *string           `json:"otlp_ca_certificate,omitempty"`     // PEM, to verify the collector
+}
+
+// UpdateOTLPConfigRequest represents a request to replace the OTLP configuration.
+// Nil fields are cleared.
+type UpdateOTLPConfigRequest struct {
+	Enabled               bool              `json:"enabled"`
+	Endpoint              *string           `json:"endpoint"`
+	Protocol              string            `json:"protocol,omitempty"`
+	Compression           string            `json:"compression,omitempty"`
+	Headers               map[string]string `json:"headers"`
+	ExportIntervalSeconds *int              `json:"export_interval_seconds,omitempty"`
+	ResourceAttributes    map[string]string `json:"resource_attributes"`
+	MetricFamilies        []string          `json:"metric_families"`
+	ClientCertificate     *string           `json:"client_certificate"`
+	ClientKey             *string           `json:"client_key"`
+	CACertificate         *string           `json:"ca_certificate"`
+}
+
+// GetOTLPConfig retrieves the organization's OTLP export configuration
+func (c *Client) GetOTLPConfig() (*OTLPConfig, error) {
+	data, err := c.DoRequest(http.MethodGet, "/v1/org/otlp", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The config is nested in a second data envelope
+	var resp struct {
+		Data OTLPConfig `json:"data"`
+	}
+	if err := UnmarshalAPIResponse(data, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Data, nil
+}
+
+// UpdateOTLPConfig replaces the organization's OTLP export configuration
+func (c *Client) UpdateOTLPConfig(req UpdateOTLPConfigRequest) error {
+	_, err := c.DoRequest(http.MethodPut, "/v1/org/otlp", req)
+	return err
+}
+
+// DisableOTLP disables OTLP export and clears the stored endpoint, headers and
+// certificates
+func (c *Client) DisableOTLP() error {
+	return c.UpdateOTLPConfig(UpdateOTLPConfigRequest{Enabled: false})
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Private location agent statuses
+const (
+	AgentStatusOnline  = "online"
+	AgentStatusOffline = "offline" // No heartbeat within the last 2 minutes
+)
+
+// PrivateLocation is a self-hosted probe location. Its ID can be used like a public
+// region code in a check's regions. Agents enroll with EnrollmentToken, which is only
+// returned when the location is created.
+type PrivateLocation struct {
+	ID              string                 `json:"id"`
+	OrgID           string                 `json:"org_id"`
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description,omitempty"`
+	EnrollmentToken string                 `json:"enrollment_token,omitempty"`
+	Status          string                 `json:"status"` // pending (no agent enrolled yet), online, degraded, or offline
+	Agents          []PrivateLocationAgent `json:"agents"`
+	CreatedAt       string                 `json:"created_at"`
+	UpdatedAt       string                 `json:"updated_at"`
+}
+
+// PrivateLocationAgent is a probe agent enrolled in a private location
+type PrivateLocationAgent struct {
+	ID         string  `json:"id"`
+	Hostname   string  `json:"hostname"`
+	Version    string  `json:"version"`
+	Status     string  `json:"status"` // online or offline
+	LastSeenAt *string `json:"last_seen_at,omitempty"`
+}
+
+// CreatePrivateLocationRequest represents a request to create a private location
+type CreatePrivateLocationRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// UpdatePrivateLocationRequest represents a request to update a private location
+type UpdatePrivateLocationRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ListPrivateLocations retrieves all private locations
+func (c *Client) ListPrivateLocations() ([]PrivateLocation, error) {
+	data, err := c.DoRequest(http.MethodGet, "/v1/private-locations", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var locations []PrivateLocation
+	if err := UnmarshalAPIResponse(data, &locations); err != nil {
+		return nil, err
+	}
+
+	return locations, nil
+}
+
+// GetPrivateLocation retrieves a specific private location by ID, including the
+// health of its agents
+func (c *Client) GetPrivateLocation(id string) (*PrivateLocation, error) {
+	data, err := c.DoRequest(http.MethodGet, fmt.Sprintf("/v1/private-locations/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var location PrivateLocation
+	if err := UnmarshalAPIResponse(data, &location); err != nil {
+		return nil, err
+	}
+
+	return &location, nil
+}
+
+// CreatePrivateLocation creates a new private location
+func (c *Client) CreatePrivateLocation(req CreatePrivateLocationRequest) (*PrivateLocation, error) {
+	data, err := c.DoRequest(http.MethodPost, "/v1/private-locations", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var location PrivateLocation
+	if err := UnmarshalAPIResponse(data, &location); err != nil {
+		return nil, err
+	}
+
+	return &location, nil
+}
+
+// UpdatePrivateLocation updates an existing private location
+func (c *Client) UpdatePrivateLocation(id string, req UpdatePrivateLocationRequest) (*PrivateLocation, error) {
+	data, err := c.DoRequest(http.MethodPut, fmt.Sprintf("/v1/private-locations/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var location PrivateLocation
+	if err := UnmarshalAPIResponse(data, &location); err != nil {
+		return nil, err
+	}
+
+	return &location, nil
+}
+
+// DeletePrivateLocation deletes a private location and unenrolls its agents. The
+// API rejects the request while checks still run in the location.
+func (c *Client) DeletePrivateLocation(id string) error {
+	_, err := c.DoRequest(http.MethodDelete, fmt.Sprintf("/v1/private-locations/%s", id), nil)
+	return err
+}
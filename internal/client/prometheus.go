@@ -0,0 +1,77 @@
+package client
+
+import (
+	"net/http"
+)
+
+// PrometheusRelabelActions lists the supported relabel rule actions
+var PrometheusRelabelActions = []string{"replace", "keep", "drop", "labelkeep", "labeldrop"}
+
+// PrometheusConfig represents the organization's Prometheus export configuration:
+// remote-write to a Prometheus-compatible backend, or an authenticated /metrics
+// endpoint that Prometheus scrapes
+type PrometheusConfig struct {
+	RemoteWrite           *PrometheusRemoteWrite `json:"remote_write,omitempty"`
+	ScrapeEndpointEnabled bool                   `json:"scrape_endpoint_enabled"`
+	ScrapeURL             string                 `json:"scrape_url,omitempty"`
+	ScrapeToken           string                 `json:"scrape_token,omitempty"` // Only returned when the endpoint is enabled
+}
+
+// PrometheusRemoteWrite configures remote-write. Password and BearerToken are
+// write-only and never returned; CredentialsHash changes whenever they are modified.
+type PrometheusRemoteWrite struct {
+	URL                 string                  `json:"url"`
+	Username            string                  `json:"username,omitempty"`
+	Password            string                  `json:"password,omitempty"`
+	BearerToken         string                  `json:"bearer_token,omitempty"`
+	CredentialsHash     string                  `json:"credentials_hash,omitempty"` // Hash of username, password and bearer token for drift detection
+	SendIntervalSeconds int                     `json:"send_interval_seconds,omitempty"`
+	RelabelRules        []PrometheusRelabelRule `json:"relabel_rules,omitempty"`
+}
+
+// PrometheusRelabelRule is a Prometheus relabel_config applied before sending
+type PrometheusRelabelRule struct {
+	Action       string   `json:"action"`
+	SourceLabels []string `json:"source_labels,omitempty"`
+	Separator    string   `json:"separator,omitempty"`
+	Regex        string   `json:"regex,omitempty"`
+	TargetLabel  string   `json:"target_label,omitempty"`
+	Replacement  string   `json:"replacement,omitempty"`
+}
+
+// GetPrometheusConfig retrieves the organization's Prometheus export configuration
+func (c *Client) GetPrometheusConfig() (*PrometheusConfig, error) {
+	data, err := c.DoRequest(http.MethodGet, "/v1/org/prometheus", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var config PrometheusConfig
+	if err := UnmarshalAPIResponse(data, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// UpdatePrometheusConfig replaces the organization's Prometheus export configuration
+func (c *Client) UpdatePrometheusConfig(config PrometheusConfig) (*PrometheusConfig, error) {
+	data, err := c.DoRequest(http.MethodPut, "/v1/org/prometheus", config)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated PrometheusConfig
+	if err := UnmarshalAPIResponse(data, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeletePrometheusConfig disables remote-write and the scrape endpoint and revokes
+// the scrape token
+func (c *Client) DeletePrometheusConfig() error {
+	_, err := c.DoRequest(http.MethodDelete, "/v1/org/prometheus", nil)
+	return err
+}
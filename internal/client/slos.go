@@ -0,0 +1,151 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// SLO represents a service level objective over one or more checks
+type SLO struct {
+	ID                 string             `json:"id"`
+	OrgID              string             `json:"org_id"`
+	Name               string             `json:"name"`
+	Description        string             `json:"description,omitempty"`
+	Target             float64            `json:"target"`                    // Percentage, e.g. 99.9
+	Window             string             `json:"window"`                    // rolling or calendar
+	WindowDays         int                `json:"window_days,omitempty"`     // Rolling windows only
+	CalendarPeriod     string             `json:"calendar_period,omitempty"` // Calendar windows only: week, month, or quarter
+	CheckIDs           []string           `json:"check_ids"`
+	SLI                string             `json:"sli"`                            // availability or latency
+	LatencyThresholdMs int                `json:"latency_threshold_ms,omitempty"` // Latency SLIs only
+	BurnRateAlerts     []SLOBurnRateAlert `json:"burn_rate_alerts"`
+	CreatedAt          string             `json:"created_at"`
+	UpdatedAt          string             `json:"updated_at"`
+}
+
+// SLOBurnRateAlert notifies channels when the error budget is consumed faster than the threshold
+type SLOBurnRateAlert struct {
+	Threshold              float64  `json:"threshold"` // Burn rate multiple, e.g. 14.4
+	WindowMinutes          int      `json:"window_minutes"`
+	NotificationChannelIDs []string `json:"notification_channel_ids"`
+}
+
+// SLOStatus represents the current attainment and error budget of an SLO
+type SLOStatus struct {
+	SLOID                       string  `json:"slo_id"`
+	Attainment                  float64 `json:"attainment"`                     // Percentage of good events in the current window
+	ErrorBudgetRemaining        float64 `json:"error_budget_remaining"`         // Percentage of the budget left; negative when exhausted
+	ErrorBudgetRemainingMinutes float64 `json:"error_budget_remaining_minutes"` // Budget left expressed as minutes of full downtime
+	BurnRate                    float64 `json:"burn_rate"`                      // Current burn rate multiple
+	WindowStart                 string  `json:"window_start"`
+	WindowEnd                   string  `json:"window_end"`
+}
+
+// CreateSLORequest represents a request to create an SLO
+type CreateSLORequest struct {
+	Name               string             `json:"name"`
+	Description        string             `json:"description,omitempty"`
+	Target             float64            `json:"target"`
+	Window             string             `json:"window"`
+	WindowDays         int                `json:"window_days,omitempty"`
+	CalendarPeriod     string             `json:"calendar_period,omitempty"`
+	CheckIDs           []string           `json:"check_ids"`
+	SLI                string             `json:"sli"`
+	LatencyThresholdMs int                `json:"latency_threshold_ms,omitempty"`
+	BurnRateAlerts     []SLOBurnRateAlert `json:"burn_rate_alerts"`
+}
+
+// UpdateSLORequest represents a request to update an SLO
+type UpdateSLORequest struct {
+	Name               *string             `json:"name,omitempty"`
+	Description        *string             `json:"description,omitempty"`
+	Target             *float64            `json:"target,omitempty"`
+	Window             *string             `json:"window,omitempty"`
+	WindowDays         *int                `json:"window_days,omitempty"`
+	CalendarPeriod     *string             `json:"calendar_period,omitempty"`
+	CheckIDs           *[]string           `json:"check_ids,omitempty"`
+	SLI                *string             `json:"sli,omitempty"`
+	LatencyThresholdMs *int                `json:"latency_threshold_ms,omitempty"`
+	BurnRateAlerts     *[]SLOBurnRateAlert `json:"burn_rate_alerts,omitempty"`
+}
+
+// ListSLOs retrieves all SLOs
+func (c *Client) ListSLOs() ([]SLO, error) {
+	data, err := c.DoRequest(http.MethodGet, "/v1/slos", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var slos []SLO
+	if err := UnmarshalAPIResponse(data, &slos); err != nil {
+		return nil, err
+	}
+
+	return slos, nil
+}
+
+// GetSLO retrieves a specific SLO by ID
+func (c *Client) GetSLO(id string) (*SLO, error) {
+	data, err := c.DoRequest(http.MethodGet, fmt.Sprintf("/v1/slos/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var slo SLO
+	if err := UnmarshalAPIResponse(data, &slo); err != nil {
+		return nil, err
+	}
+
+	return &slo, nil
+}
+
+// GetSLOStatus retrieves the current attainment and error budget of an SLO
+func (c *Client) GetSLOStatus(id string) (*SLOStatus, error) {
+	data, err := c.DoRequest(http.MethodGet, fmt.Sprintf("/v1/slos/%s/status", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status SLOStatus
+	if err := UnmarshalAPIResponse(data, &status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// CreateSLO creates a new SLO
+func (c *Client) CreateSLO(req CreateSLORequest) (*SLO, error) {
+	data, err := c.DoRequest(http.MethodPost, "/v1/slos", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var slo SLO
+	if err := UnmarshalAPIResponse(data, &slo); err != nil {
+		return nil, err
+	}
+
+	return &slo, nil
+}
+
+// UpdateSLO updates an existing SLO
+func (c *Client) UpdateSLO(id string, req UpdateSLORequest) (*SLO, error) {
+	data, err := c.DoRequest(http.MethodPut, fmt.Sprintf("/v1/slos/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var slo SLO
+	if err := UnmarshalAPIResponse(data, &slo); err != nil {
+		return nil, err
+	}
+
+	return &slo, nil
+}
+
+// DeleteSLO deletes an SLO
+func (c *Client) DeleteSLO(id string) error {
+	_, err := c.DoRequest(http.MethodDelete, fmt.Sprintf("/v1/slos/%s", id), nil)
+	return err
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// StatusPageComponent groups several checks into one customer-facing entry on a status page
+type StatusPageComponent struct {
+	ID           string             `json:"id"`
+	StatusPageID string             `json:"status_page_id"`
+	Name         string             `json:"name"`
+	Description  string             `json:"description,omitempty"`
+	CheckIDs     []string           `json:"check_ids"`
+	Aggregation  string             `json:"aggregation"`       // any_down, majority_down, or weighted
+	Weights      map[string]float64 `json:"weights,omitempty"` // check ID -> weight, used by weighted aggregation
+	DisplayOrder int                `json:"display_order"`
+	CreatedAt    string             `json:"created_at"`
+	UpdatedAt    string             `json:"updated_at"`
+}
+
+// CreateStatusPageComponentRequest represents a request to create a status page component
+type CreateStatusPageComponentRequest struct {
+	Name         string             `json:"name"`
+	Description  string             `json:"description,omitempty"`
+	CheckIDs     []string           `json:"check_ids"`
+	Aggregation  string             `json:"aggregation"`
+	Weights      map[string]float64 `json:"weights,omitempty"`
+	DisplayOrder int                `json:"display_order"`
+}
+
+// UpdateStatusPageComponentRequest represents a request to update a status page component
+type UpdateStatusPageComponentRequest struct {
+	Name         *string             `json:"name,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	CheckIDs     *[]string           `json:"check_ids,omitempty"`
+	Aggregation  *string             `json:"aggregation,omitempty"`
+	Weights      *map[string]float64 `json:"weights,omitempty"`
+	DisplayOrder *int                `json:"display_order,omitempty"`
+}
+
+// ListStatusPageComponents retrieves all components of a status page
+func (c *Client) ListStatusPageComponents(pageID string) ([]StatusPageComponent, error) {
+	data, err := c.DoRequest(http.MethodGet, fmt.Sprintf("/v1/status-pages/%s/components", pageID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var components []StatusPageComponent
+	if err := UnmarshalAPIResponse(data, &components); err != nil {
+		return nil, err
+	}
+
+	return components, nil
+}
+
+// GetStatusPageComponent retrieves a specific status page component
+func (c *Client) GetStatusPageComponent(pageID, componentID string) (*StatusPageComponent, error) {
+	data, err := c.DoRequest(http.MethodGet, fmt.Sprintf("/v1/status-pages/%s/components/%s", pageID, componentID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var component StatusPageComponent
+	if err := UnmarshalAPIResponse(data, &component); err != nil {
+		return nil, err
+	}
+
+	return &component, nil
+}
+
+// CreateStatusPageComponent creates a new component on a status page
+func (c *Client) CreateStatusPageComponent(pageID string, req CreateStatusPageComponentRequest) (*StatusPageComponent, error) {
+	data, err := c.DoRequest(http.MethodPost, fmt.Sprintf("/v1/status-pages/%s/components", pageID), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var component StatusPageComponent
+	if err := UnmarshalAPIResponse(data, &component); err != nil {
+		return nil, err
+	}
+
+	return &component, nil
+}
+
+// UpdateStatusPageComponent updates an existing status page component
+func (c *Client) UpdateStatusPageComponent(pageID, componentID string, req UpdateStatusPageComponentRequest) (*StatusPageComponent, error) {
+	data, err := c.DoRequest(http.MethodPut, fmt.Sprintf("/v1/status-pages/%s/components/%s", pageID, componentID), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var component StatusPageComponent
+	if err := UnmarshalAPIResponse(data, &component); err != nil {
+		return nil, err
+	}
+
+	return &component, nil
+}
+
+// DeleteStatusPageComponent deletes a status page component
+func (c *Client) DeleteStatusPageComponent(pageID, componentID string) error {
+	_, err := c.DoRequest(http.MethodDelete, fmt.Sprintf("/v1/status-pages/%s/components/%s", pageID, componentID), nil)
+	return err
+}
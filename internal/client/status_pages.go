@@ -0,0 +1,126 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// StatusPage represents a public (or restricted) status page
+type StatusPage struct {
+	ID                    string              `json:"id"`
+	OrgID                 string              `json:"org_id"`
+	Slug                  string              `json:"slug"`
+	Title                 string              `json:"title"`
+	Description           string              `json:"description,omitempty"`
+	Grouping              string              `json:"grouping"`   // flat or check_type
+	Visibility            string              `json:"visibility"` // public or password
+	Branding              *StatusPageBranding `json:"branding,omitempty"`
+	CustomDomain          string              `json:"custom_domain,omitempty"`
+	IncidentRetentionDays int                 `json:"incident_retention_days"`
+	CheckIDs              []string            `json:"check_ids"`
+	PasswordHash          string              `json:"password_hash,omitempty"` // Changes whenever the password does; empty without a password
+	URL                   string              `json:"url"`
+	CreatedAt             string              `json:"created_at"`
+	UpdatedAt             string              `json:"updated_at"`
+}
+
+// StatusPageBranding holds the visual customization of a status page
+type StatusPageBranding struct {
+	LogoURL    string `json:"logo_url,omitempty"`
+	FaviconURL string `json:"favicon_url,omitempty"`
+	BrandColor string `json:"brand_color,omitempty"`
+}
+
+// CreateStatusPageRequest represents a request to create a status page
+type CreateStatusPageRequest struct {
+	Slug                  string              `json:"slug"`
+	Title                 string              `json:"title"`
+	Description           string              `json:"description,omitempty"`
+	Grouping              string              `json:"grouping,omitempty"`
+	Visibility            string              `json:"visibility,omitempty"`
+	Password              *string             `json:"password,omitempty"` // Required when visibility is password
+	Branding              *StatusPageBranding `json:"branding,omitempty"`
+	CustomDomain          string              `json:"custom_domain,omitempty"`
+	IncidentRetentionDays int                 `json:"incident_retention_days,omitempty"`
+	CheckIDs              []string            `json:"check_ids"`
+}
+
+// UpdateStatusPageRequest represents a request to update a status page
+type UpdateStatusPageRequest struct {
+	Slug                  *string             `json:"slug,omitempty"`
+	Title                 *string             `json:"title,omitempty"`
+	Description           *string             `json:"description,omitempty"`
+	Grouping              *string             `json:"grouping,omitempty"`
+	Visibility            *string             `json:"visibility,omitempty"`
+	Password              *string             `json:"password,omitempty"`
+	Branding              *StatusPageBranding `json:"branding,omitempty"`
+	CustomDomain          *string             `json:"custom_domain,omitempty"`
+	IncidentRetentionDays *int                `json:"incident_retention_days,omitempty"`
+	CheckIDs              *[]string           `json:"check_ids,omitempty"`
+}
+
+// ListStatusPages retrieves all status pages
+func (c *Client) ListStatusPages() ([]StatusPage, error) {
+	data, err := c.DoRequest(http.MethodGet, "/v1/status-pages", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pages []StatusPage
+	if err := UnmarshalAPIResponse(data, &pages); err != nil {
+		return nil, err
+	}
+
+	return pages, nil
+}
+
+// GetStatusPage retrieves a specific status page by ID
+func (c *Client) GetStatusPage(id string) (*StatusPage, error) {
+	data, err := c.DoRequest(http.MethodGet, fmt.Sprintf("/v1/status-pages/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var page StatusPage
+	if err := UnmarshalAPIResponse(data, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// CreateStatusPage creates a new status page
+func (c *Client) CreateStatusPage(req CreateStatusPageRequest) (*StatusPage, error) {
+	data, err := c.DoRequest(http.MethodPost, "/v1/status-pages", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var page StatusPage
+	if err := UnmarshalAPIResponse(data, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// UpdateStatusPage updates an existing status page
+func (c *Client) UpdateStatusPage(id string, req UpdateStatusPageRequest) (*StatusPage, error) {
+	data, err := c.DoRequest(http.MethodPut, fmt.Sprintf("/v1/status-pages/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var page StatusPage
+	if err := UnmarshalAPIResponse(data, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// DeleteStatusPage deletes a status page
+func (c *Client) DeleteStatusPage(id string) error {
+	_, err := c.DoRequest(http.MethodDelete, fmt.Sprintf("/v1/status-pages/%s", id), nil)
+	return err
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// TeamMember represents a user with access to the organization
+type TeamMember struct {
+	ID        string `json:"id"`
+	OrgID     string `json:"org_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`   // owner, admin, editor, or viewer
+	Status    string `json:"status"` // invited or active
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// CreateTeamMemberRequest represents a request to invite a team member
+type CreateTeamMemberRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// UpdateTeamMemberRequest represents a request to change a team member's role
+type UpdateTeamMemberRequest struct {
+	Role *string `json:"role,omitempty"`
+}
+
+// Team represents a named group of team members
+type Team struct {
+	ID          string   `json:"id"`
+	OrgID       string   `json:"org_id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	MemberIDs   []string `json:"member_ids"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+// CreateTeamRequest represents a request to create a team
+type CreateTeamRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	MemberIDs   []string `json:"member_ids"`
+}
+
+// UpdateTeamRequest represents a request to update a team
+type UpdateTeamRequest struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	MemberIDs   *[]string `json:"member_ids,omitempty"`
+}
+
+// ListTeamMembers retrieves all members of the organization
+func (c *Client) ListTeamMembers() ([]TeamMember, error) {
+	data, err := c.DoRequest(http.MethodGet, "/v1/team/members", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []TeamMember
+	if err := UnmarshalAPIResponse(data, &members); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// GetTeamMember retrieves a specific team member by ID
+func (c *Client) GetTeamMember(id string) (*TeamMember, error) {
+	data, err := c.DoRequest(http.MethodGet, fmt.Sprintf("/v1/team/members/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var member TeamMember
+	if err := UnmarshalAPIResponse(data, &member); err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+// CreateTeamMember invites a user to the organization
+func (c *Client) CreateTeamMember(req CreateTeamMemberRequest) (*TeamMember, error) {
+	data, err := c.DoRequest(http.MethodPost, "/v1/team/members", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var member TeamMember
+	if err := UnmarshalAPIResponse(data, &member); err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+// UpdateTeamMember updates a team member's role
+func (c *Client) UpdateTeamMember(id string, req UpdateTeamMemberRequest) (*TeamMember, error) {
+	data, err := c.DoRequest(http.MethodPut, fmt.Sprintf("/v1/team/members/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var member TeamMember
+	if err := UnmarshalAPIResponse(data, &member); err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+// DeleteTeamMember removes a user from the organization (or revokes a pending invite)
+func (c *Client) DeleteTeamMember(id string) error {
+	_, err := c.DoRequest(http.MethodDelete, fmt.Sprintf("/v1/team/members/%s", id), nil)
+	return err
+}
+
+// ListTeams retrieves all teams
+func (c *Client) ListTeams() ([]Team, error) {
+	data, err := c.DoRequest(http.MethodGet, "/v1/teams", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []Team
+	if err := UnmarshalAPIResponse(data, &teams); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+// GetTeam retrieves a specific team by ID
+func (c *Client) GetTeam(id string) (*Team, error) {
+	data, err := c.DoRequest(http.MethodGet, fmt.Sprintf("/v1/teams/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var team Team
+	if err := UnmarshalAPIResponse(data, &team); err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// CreateTeam creates a new team
+func (c *Client) CreateTeam(req CreateTeamRequest) (*Team, error) {
+	data, err := c.DoRequest(http.MethodPost, "/v1/teams", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var team Team
+	if err := UnmarshalAPIResponse(data, &team); err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// UpdateTeam updates an existing team
+func (c *Client) UpdateTeam(id string, req UpdateTeamRequest) (*Team, error) {
+	data, err := c.DoRequest(http.MethodPut, fmt.Sprintf("/v1/teams/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var team Team
+	if err := UnmarshalAPIResponse(data, &team); err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// DeleteTeam deletes a team. Its members remain in the organization.
+func (c *Client) DeleteTeam(id string) error {
+	_, err := c.DoRequest(http.MethodDelete, fmt.Sprintf("/v1/teams/%s", id), nil)
+	return err
+}
@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// apiKeyScopes lists the scopes that can be granted to an API key.
+var apiKeyScopes = []string{"read-only", "checks:write", "alerts:write"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &apiKeyResource{}
+	_ resource.ResourceWithConfigure   = &apiKeyResource{}
+	_ resource.ResourceWithImportState = &apiKeyResource{}
+)
+
+// NewAPIKeyResource is a helper function to simplify the provider implementation.
+func NewAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+// apiKeyResource is the resource implementation.
+type apiKeyResource struct {
+	client *client.Client
+}
+
+// apiKeyResourceModel maps the resource schema data.
+type apiKeyResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	OrgID           types.String `tfsdk:"org_id"`
+	Name            types.String `tfsdk:"name"`
+	Scopes          types.Set    `tfsdk:"scopes"`
+	AllowedIPs      types.Set    `tfsdk:"allowed_ips"`
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+	Keepers         types.Map    `tfsdk:"keepers"`
+	Key             types.String `tfsdk:"key"`
+	KeyPrefix       types.String `tfsdk:"key_prefix"`
+	LastUsedAt      types.String `tfsdk:"last_used_at"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a scoped Quismon API key. Changing scopes, expires_at, rotation_trigger or keepers " +
+			"replaces the key; combine with lifecycle { create_before_destroy = true } for zero-downtime rotation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "API key ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "Organization ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "API key name.",
+				Required:    true,
+			},
+			"scopes": schema.SetAttribute{
+				Description: "Scopes granted to the key: read-only, checks:write, alerts:write. Changing this will force a new key.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(apiKeyScopes...)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_ips": schema.SetAttribute{
+				Description: "IP addresses or CIDR blocks allowed to use the key. If omitted, the key can be used from any address.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(IPOrCIDR()),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp after which the key stops working. If omitted, the key does not expire. Changing this will force a new key.",
+				Optional:    true,
+				Validators: []validator.String{
+					RFC3339Timestamp(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Description: "Arbitrary value that forces a new key when changed, e.g. time_rotating.this.id.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that forces a new key when any of them change.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The secret API key. Only available after creation; it is null for imported keys.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_prefix": schema.StringAttribute{
+				Description: "Non-secret prefix of the key, useful for identifying it in audit logs.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": schema.StringAttribute{
+				Description: "Timestamp the key was last used.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan apiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopes []string
+	diags = plan.Scopes.ElementsAs(ctx, &scopes, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var allowedIPs []string
+	if !plan.AllowedIPs.IsNull() {
+		diags = plan.AllowedIPs.ElementsAs(ctx, &allowedIPs, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	createReq := client.CreateAPIKeyRequest{
+		Name:       plan.Name.ValueString(),
+		Scopes:     scopes,
+		AllowedIPs: allowedIPs,
+		ExpiresAt:  plan.ExpiresAt.ValueStringPointer(),
+	}
+
+	key, err := r.client.CreateAPIKey(createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating API Key",
+			"Could not create API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The secret is only returned on creation
+	plan.ID = types.StringValue(key.ID)
+	plan.Key = types.StringValue(key.Key)
+	r.mapAPIKeyToModel(key, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.GetAPIKey(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading API Key",
+			"Could not read API key ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Note: the secret key is never returned after creation, so state.Key is preserved.
+	state.Name = types.StringValue(key.Name)
+	scopes, diags := types.SetValueFrom(ctx, types.StringType, key.Scopes)
+	resp.Diagnostics.Append(diags...)
+	state.Scopes = scopes
+	if len(key.AllowedIPs) > 0 {
+		allowedIPs, diags := types.SetValueFrom(ctx, types.StringType, key.AllowedIPs)
+		resp.Diagnostics.Append(diags...)
+		state.AllowedIPs = allowedIPs
+	} else {
+		state.AllowedIPs = types.SetNull(types.StringType)
+	}
+	// expires_at is immutable, so only populate it when missing (e.g. after import)
+	// to avoid replacing the key over timestamp formatting differences.
+	if state.ExpiresAt.IsNull() && key.ExpiresAt != nil {
+		state.ExpiresAt = types.StringValue(*key.ExpiresAt)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.mapAPIKeyToModel(key, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Only name and allowed_ips can change in place; everything else forces a new key.
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan apiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allowedIPs := []string{}
+	if !plan.AllowedIPs.IsNull() {
+		diags = plan.AllowedIPs.ElementsAs(ctx, &allowedIPs, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	name := plan.Name.ValueString()
+	updateReq := client.UpdateAPIKeyRequest{
+		Name:       &name,
+		AllowedIPs: &allowedIPs,
+	}
+
+	key, err := r.client.UpdateAPIKey(plan.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating API Key",
+			"Could not update API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	r.mapAPIKeyToModel(key, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete revokes the API key and removes the Terraform state on success.
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAPIKey(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting API Key",
+			"Could not revoke API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state. The secret key cannot be recovered on import.
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapAPIKeyToModel copies the computed fields of an API response into the model.
+func (r *apiKeyResource) mapAPIKeyToModel(key *client.APIKey, model *apiKeyResourceModel) {
+	model.OrgID = types.StringValue(key.OrgID)
+	model.KeyPrefix = types.StringValue(key.KeyPrefix)
+	if key.LastUsedAt != nil {
+		model.LastUsedAt = types.StringValue(*key.LastUsedAt)
+	} else {
+		model.LastUsedAt = types.StringNull()
+	}
+	model.CreatedAt = types.StringValue(key.CreatedAt)
+	model.UpdatedAt = types.StringValue(key.UpdatedAt)
+}
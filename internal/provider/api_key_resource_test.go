@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccAPIKeyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAPIKeyConfig("ci-readonly", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_api_key.test", "name", "ci-readonly"),
+					resource.TestCheckResourceAttr("quismon_api_key.test", "scopes.#", "1"),
+					resource.TestCheckResourceAttr("quismon_api_key.test", "allowed_ips.#", "1"),
+					resource.TestCheckResourceAttrSet("quismon_api_key.test", "id"),
+					resource.TestCheckResourceAttrSet("quismon_api_key.test", "key"),
+					resource.TestCheckResourceAttrSet("quismon_api_key.test", "key_prefix"),
+				),
+			},
+			// ImportState testing - the secret key cannot be recovered
+			{
+				ResourceName:            "quismon_api_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key", "rotation_trigger"},
+			},
+			// Rename in place
+			{
+				Config: testAccAPIKeyConfig("ci-readonly-renamed", "v1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("quismon_api_key.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("quismon_api_key.test", "name", "ci-readonly-renamed"),
+			},
+			// Rotation replaces the key
+			{
+				Config: testAccAPIKeyConfig("ci-readonly-renamed", "v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("quismon_api_key.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccAPIKeyResource_InvalidScope(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "quismon_api_key" "test" {
+  name   = "bad-scope"
+  scopes = ["checks:delete"]
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccAPIKeyConfig(name, rotation string) string {
+	return fmt.Sprintf(`
+resource "quismon_api_key" "test" {
+  name             = %[1]q
+  scopes           = ["read-only"]
+  allowed_ips      = ["203.0.113.0/24"]
+  rotation_trigger = %[2]q
+}
+`, name, rotation)
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// browserScriptMaxBytes is the largest script a browser check accepts.
+const browserScriptMaxBytes = 64 * 1024
+
+// browserStepFields lists the fields each browser step action requires. Fields in
+// optional may be set as well; anything else is rejected so typos surface early.
+var browserStepFields = map[string]struct{ required, optional []string }{
+	"navigate":          {required: []string{"url"}},
+	"click":             {required: []string{"selector"}},
+	"fill":              {required: []string{"selector", "value"}},
+	"select":            {required: []string{"selector", "value"}},
+	"press":             {required: []string{"key"}, optional: []string{"selector"}},
+	"hover":             {required: []string{"selector"}},
+	"wait_for_selector": {required: []string{"selector"}},
+	"wait_for_url":      {required: []string{"url"}},
+	"assert_text":       {required: []string{"text"}, optional: []string{"selector"}},
+	"assert_visible":    {required: []string{"selector"}},
+	"screenshot":        {},
+}
+
+// browserStepCommonFields may be set on any browser step.
+var browserStepCommonFields = []string{"name", "action", "timeout_seconds"}
+
+// validateBrowserConfig validates a browser check. The check runs either a
+// Playwright script (script, e.g. from file()) or a list of declarative steps
+// (steps, in config_json) in a headless Chromium, following the layout of the
+// multistep config: per-step timeout_seconds within an overall timeout_seconds.
+func validateBrowserConfig(cfg checkConfig) []string {
+	var problems []string
+
+	script, hasScript := cfg.string("script")
+	steps, hasSteps, err := cfg.objects("steps")
+	if err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	switch {
+	case hasScript && hasSteps:
+		problems = append(problems, "set either script or steps, not both")
+	case !hasScript && !hasSteps:
+		problems = append(problems, "one of script or steps is required")
+	}
+
+	if hasScript {
+		if strings.TrimSpace(script) == "" {
+			problems = append(problems, "script must not be blank")
+		}
+		if len(script) > browserScriptMaxBytes {
+			problems = append(problems, fmt.Sprintf("script must be at most %d bytes, got %d", browserScriptMaxBytes, len(script)))
+		}
+	}
+
+	timeout, hasTimeout, err := cfg.int("timeout_seconds")
+	if err != nil {
+		problems = append(problems, err.Error())
+	} else if hasTimeout && (timeout < 10 || timeout > 300) {
+		problems = append(problems, fmt.Sprintf("timeout_seconds must be between 10 and 300, got %d", timeout))
+	}
+
+	if hasSteps {
+		if len(steps) == 0 {
+			problems = append(problems, "steps must not be empty")
+		}
+		var stepTimeouts int64
+		names := map[string]bool{}
+		for i, step := range steps {
+			stepProblems, stepTimeout := validateBrowserStep(i, step, names)
+			problems = append(problems, stepProblems...)
+			stepTimeouts += stepTimeout
+		}
+		if len(steps) > 0 {
+			if action, _ := steps[0].string("action"); action != "" && action != "navigate" {
+				problems = append(problems, "the first step must be a navigate action")
+			}
+		}
+		if hasTimeout && stepTimeouts > timeout {
+			problems = append(problems, fmt.Sprintf("the step timeouts add up to %d seconds, more than timeout_seconds (%d)", stepTimeouts, timeout))
+		}
+	}
+
+	if _, _, err := cfg.bool("screenshot_on_failure"); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	if viewport, ok, err := cfg.object("viewport"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok {
+		problems = append(problems, validateBrowserViewport(viewport)...)
+	}
+
+	if vitals, ok, err := cfg.object("web_vitals"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok {
+		problems = append(problems, validateBrowserWebVitals(vitals)...)
+	}
+
+	return problems
+}
+
+// validateBrowserStep validates the i-th browser step and returns its timeout in
+// seconds (0 if unset). names collects step names to detect duplicates.
+func validateBrowserStep(i int, step checkConfig, names map[string]bool) ([]string, int64) {
+	var problems []string
+	prefix := fmt.Sprintf("steps[%d]", i)
+
+	if name, ok := step.string("name"); !ok {
+		problems = append(problems, prefix+": name is required")
+	} else {
+		if names[name] {
+			problems = append(problems, fmt.Sprintf("%s: duplicate step name %q", prefix, name))
+		}
+		names[name] = true
+		prefix = fmt.Sprintf("%s (%s)", prefix, name)
+	}
+
+	timeout, hasTimeout, err := step.int("timeout_seconds")
+	if err != nil {
+		problems = append(problems, prefix+": "+err.Error())
+	} else if hasTimeout && (timeout < 1 || timeout > 120) {
+		problems = append(problems, fmt.Sprintf("%s: timeout_seconds must be between 1 and 120, got %d", prefix, timeout))
+	}
+
+	action, ok := step.string("action")
+	if !ok {
+		return append(problems, prefix+": action is required"), timeout
+	}
+	fields, known := browserStepFields[action]
+	if !known {
+		actions := slices.Sorted(maps.Keys(browserStepFields))
+		return append(problems, fmt.Sprintf("%s: unknown action %q (expected one of %s)", prefix, action, strings.Join(actions, ", "))), timeout
+	}
+
+	for _, field := range fields.required {
+		if !step.has(field) {
+			problems = append(problems, fmt.Sprintf("%s: %s is required for the %s action", prefix, field, action))
+		}
+	}
+	allowed := slices.Concat(browserStepCommonFields, fields.required, fields.optional)
+	for _, field := range slices.Sorted(maps.Keys(step)) {
+		if step.has(field) && !slices.Contains(allowed, field) {
+			problems = append(problems, fmt.Sprintf("%s: %s is not supported by the %s action", prefix, field, action))
+		}
+	}
+
+	if action == "navigate" {
+		if raw, ok := step.string("url"); ok {
+			if u, err := url.Parse(raw); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				problems = append(problems, fmt.Sprintf("%s: url %q must be an absolute http:// or https:// URL", prefix, raw))
+			}
+		}
+	}
+
+	return problems, timeout
+}
+
+// validateBrowserViewport validates the browser window size.
+func validateBrowserViewport(viewport checkConfig) []string {
+	var problems []string
+	for _, dim := range []struct {
+		key      string
+		min, max int64
+	}{{"width", 320, 3840}, {"height", 240, 2160}} {
+		v, ok, err := viewport.int(dim.key)
+		switch {
+		case err != nil:
+			problems = append(problems, "viewport."+err.Error())
+		case !ok:
+			problems = append(problems, fmt.Sprintf("viewport.%s is required", dim.key))
+		case v < dim.min || v > dim.max:
+			problems = append(problems, fmt.Sprintf("viewport.%s must be between %d and %d, got %d", dim.key, dim.min, dim.max, v))
+		}
+	}
+	return problems
+}
+
+// validateBrowserWebVitals validates the Core Web Vitals thresholds above which
+// the check fails: lcp_ms (Largest Contentful Paint), inp_ms (Interaction to Next
+// Paint) and cls (Cumulative Layout Shift).
+func validateBrowserWebVitals(vitals checkConfig) []string {
+	var problems []string
+	for _, key := range []string{"lcp_ms", "inp_ms"} {
+		if v, ok, err := vitals.int(key); err != nil {
+			problems = append(problems, "web_vitals."+err.Error())
+		} else if ok && v <= 0 {
+			problems = append(problems, fmt.Sprintf("web_vitals.%s must be positive, got %d", key, v))
+		}
+	}
+	if v, ok, err := vitals.float("cls"); err != nil {
+		problems = append(problems, "web_vitals."+err.Error())
+	} else if ok && v < 0 {
+		problems = append(problems, fmt.Sprintf("web_vitals.cls must not be negative, got %g", v))
+	}
+	for _, key := range slices.Sorted(maps.Keys(vitals)) {
+		if key != "lcp_ms" && key != "inp_ms" && key != "cls" {
+			problems = append(problems, fmt.Sprintf("web_vitals.%s is not supported (expected lcp_ms, inp_ms or cls)", key))
+		}
+	}
+	return problems
+}
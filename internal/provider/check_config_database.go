@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// sqlReadOnlyStatements lists the statements a postgres or mysql check may run.
+var sqlReadOnlyStatements = []string{"SELECT", "WITH", "SHOW", "EXPLAIN", "VALUES"}
+
+// redisReadOnlyCommands lists the commands a redis check may run.
+var redisReadOnlyCommands = []string{
+	"PING", "ECHO", "INFO", "DBSIZE", "EXISTS", "GET", "STRLEN", "TTL", "TYPE",
+	"HGET", "HLEN", "LLEN", "SCARD", "ZCARD", "XLEN", "ROLE",
+}
+
+// validateSQLConfig validates a postgres or mysql check. The check logs in as
+// username with password (write-only, covered by config_hash like smtp_password)
+// and runs query (SELECT 1 by default), optionally asserting on the result with
+// expected_rows, or expected_value, min_value and max_value on the first column
+// of the first row, e.g. for a replication-lag threshold.
+func validateSQLConfig(cfg checkConfig) []string {
+	var problems []string
+
+	problems = append(problems, validateDatabaseConnection(cfg)...)
+	if !cfg.has("username") {
+		problems = append(problems, "username is required")
+	}
+
+	if query, ok := cfg.string("query"); ok {
+		problems = append(problems, validateSQLQuery(query)...)
+	}
+
+	if rows, ok, err := cfg.int("expected_rows"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok && rows < 0 {
+		problems = append(problems, fmt.Sprintf("expected_rows must not be negative, got %d", rows))
+	}
+	problems = append(problems, validateDatabaseValueAssertions(cfg)...)
+
+	return problems
+}
+
+// validateSQLQuery checks that query is a single read-only statement.
+func validateSQLQuery(query string) []string {
+	var problems []string
+
+	statement := strings.TrimSuffix(strings.TrimSpace(query), ";")
+	if strings.Contains(statement, ";") {
+		problems = append(problems, "query must be a single statement")
+	}
+	keyword := firstWord(statement)
+	if !slices.Contains(sqlReadOnlyStatements, keyword) {
+		problems = append(problems, fmt.Sprintf("query must be a read-only statement starting with one of %s, got %q",
+			strings.Join(sqlReadOnlyStatements, ", "), keyword))
+	}
+
+	return problems
+}
+
+// validateRedisConfig validates a redis check. The check authenticates with
+// password (and username for ACL users), selects database (0-15) and runs a
+// read-only command (PING by default), optionally asserting on the reply with
+// expected_value, min_value and max_value.
+func validateRedisConfig(cfg checkConfig) []string {
+	var problems []string
+
+	problems = append(problems, validateDatabaseConnection(cfg)...)
+	if cfg.has("username") && !cfg.has("password") {
+		problems = append(problems, "username requires password")
+	}
+
+	if db, ok, err := cfg.int("database"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok && (db < 0 || db > 15) {
+		problems = append(problems, fmt.Sprintf("database must be between 0 and 15, got %d", db))
+	}
+
+	if command, ok := cfg.string("command"); ok {
+		name := firstWord(command)
+		if !slices.Contains(redisReadOnlyCommands, name) {
+			problems = append(problems, fmt.Sprintf("command must be a read-only command (one of %s), got %q",
+				strings.Join(redisReadOnlyCommands, ", "), name))
+		}
+	}
+	problems = append(problems, validateDatabaseValueAssertions(cfg)...)
+
+	return problems
+}
+
+// validateMongoDBConfig validates a mongodb check. The check connects to host or
+// to uri (mongodb:// or mongodb+srv://, without credentials), authenticates with
+// username and password against auth_source, and runs command ({"ping": 1} by
+// default) on database, optionally asserting on the reply with
+// response_assertions.
+func validateMongoDBConfig(cfg checkConfig) []string {
+	var problems []string
+
+	if raw, ok := cfg.string("uri"); ok {
+		if cfg.has("host") || cfg.has("port") {
+			problems = append(problems, "set either uri or host and port, not both")
+		}
+		if u, err := url.Parse(raw); err != nil || (u.Scheme != "mongodb" && u.Scheme != "mongodb+srv") || u.Host == "" {
+			problems = append(problems, "uri must be a mongodb:// or mongodb+srv:// connection string")
+		} else if _, hasPassword := u.User.Password(); hasPassword {
+			problems = append(problems, "uri must not contain a password; set password instead so it stays redacted and covered by config_hash")
+		}
+		problems = append(problems, validateCheckTLS(cfg)...)
+		problems = append(problems, validateDatabaseTimeout(cfg)...)
+	} else {
+		problems = append(problems, validateDatabaseConnection(cfg)...)
+	}
+
+	if cfg.has("password") && !cfg.has("username") {
+		problems = append(problems, "password requires username")
+	}
+
+	switch command := cfg["command"].(type) {
+	case nil, map[string]interface{}:
+	case string:
+		// From the config map, or a jsonencode()d command in config_json
+		var obj map[string]interface{}
+		if err := json.Unmarshal([]byte(command), &obj); err != nil {
+			problems = append(problems, "command must be a JSON object such as {\"ping\": 1}: "+err.Error())
+		}
+	default:
+		problems = append(problems, "command must be a JSON object such as {\"ping\": 1}")
+	}
+	problems = append(problems, validateResponseAssertions(cfg, "response_assertions")...)
+
+	return problems
+}
+
+// validateDatabaseConnection validates the connection settings shared by the
+// database check types: host, port, TLS options and timeout_seconds.
+func validateDatabaseConnection(cfg checkConfig) []string {
+	var problems []string
+	if !cfg.has("host") {
+		problems = append(problems, "host is required")
+	}
+	problems = append(problems, validatePort(cfg, "port", false)...)
+	problems = append(problems, validateCheckTLS(cfg)...)
+	problems = append(problems, validateDatabaseTimeout(cfg)...)
+	return problems
+}
+
+// validateDatabaseTimeout checks that timeout_seconds, if set, is between 1 and 60.
+func validateDatabaseTimeout(cfg checkConfig) []string {
+	timeout, ok, err := cfg.int("timeout_seconds")
+	switch {
+	case err != nil:
+		return []string{err.Error()}
+	case ok && (timeout < 1 || timeout > 60):
+		return []string{fmt.Sprintf("timeout_seconds must be between 1 and 60, got %d", timeout)}
+	}
+	return nil
+}
+
+// validateDatabaseValueAssertions validates expected_value, and the numeric
+// min_value and max_value thresholds on a query result or command reply.
+func validateDatabaseValueAssertions(cfg checkConfig) []string {
+	var problems []string
+
+	minValue, hasMin, err := cfg.float("min_value")
+	if err != nil {
+		problems = append(problems, err.Error())
+	}
+	maxValue, hasMax, err := cfg.float("max_value")
+	if err != nil {
+		problems = append(problems, err.Error())
+	}
+	if hasMin && hasMax && minValue > maxValue {
+		problems = append(problems, fmt.Sprintf("min_value (%g) must not be greater than max_value (%g)", minValue, maxValue))
+	}
+	if cfg.has("expected_value") && (hasMin || hasMax) {
+		problems = append(problems, "set either expected_value or min_value/max_value, not both")
+	}
+
+	return problems
+}
+
+// firstWord returns the leading letters of s in upper case, e.g. "SELECT" for
+// "select(1)".
+func firstWord(s string) string {
+	s = strings.TrimSpace(s)
+	end := strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+	})
+	if end >= 0 {
+		s = s[:end]
+	}
+	return strings.ToUpper(s)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// dnsRecordTypes lists the record types a dns_propagation check can query. The
+// API matches them case-insensitively.
+var dnsRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT", "NS", "SOA"}
+
+// domainNameRegexp matches fully qualified domain names, including punycode
+// (xn--) labels, with an optional trailing dot.
+var domainNameRegexp = regexp.MustCompile(`^(?i)([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9-]{2,63}\.?$`)
+
+// validateDomainExpiryConfig validates a domain_expiry check. The check looks up
+// the registration of domain by RDAP, falling back to WHOIS, and fails when it
+// expires within expiry_threshold_days, like the ssl check does for certificates.
+func validateDomainExpiryConfig(cfg checkConfig) []string {
+	var problems []string
+
+	problems = append(problems, validateCheckDomain(cfg)...)
+
+	if days, ok, err := cfg.int("expiry_threshold_days"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok && (days < 1 || days > 365) {
+		problems = append(problems, fmt.Sprintf("expiry_threshold_days must be between 1 and 365, got %d", days))
+	}
+	problems = append(problems, validateDNSTimeout(cfg)...)
+
+	return problems
+}
+
+// validateDNSPropagationConfig validates a dns_propagation check. The check
+// queries record_type for domain on each of nameservers (a built-in set of public
+// resolvers by default) and fails when fewer than quorum_percent of them return
+// the same answer, or expected_values if set.
+func validateDNSPropagationConfig(cfg checkConfig) []string {
+	var problems []string
+
+	problems = append(problems, validateCheckDomain(cfg)...)
+
+	if recordType, ok := cfg.string("record_type"); ok && !slices.Contains(dnsRecordTypes, strings.ToUpper(recordType)) {
+		problems = append(problems, fmt.Sprintf("record_type %q is not supported (expected one of %s)", recordType, strings.Join(dnsRecordTypes, ", ")))
+	}
+
+	if nameservers, ok, err := cfg.stringList("nameservers"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok {
+		if len(nameservers) < 2 {
+			problems = append(problems, "nameservers must list at least 2 resolvers to compare")
+		}
+		seen := map[string]bool{}
+		for _, ns := range nameservers {
+			if !isNameserverAddress(ns) {
+				problems = append(problems, fmt.Sprintf("nameservers entry %q must be an IP address, optionally with a port", ns))
+			}
+			if seen[ns] {
+				problems = append(problems, fmt.Sprintf("nameservers entry %q is listed more than once", ns))
+			}
+			seen[ns] = true
+		}
+	}
+
+	if quorum, ok, err := cfg.int("quorum_percent"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok && (quorum < 1 || quorum > 100) {
+		problems = append(problems, fmt.Sprintf("quorum_percent must be between 1 and 100, got %d", quorum))
+	}
+
+	if values, ok, err := cfg.stringList("expected_values"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok && len(values) == 0 {
+		problems = append(problems, "expected_values must not be empty")
+	}
+	problems = append(problems, validateDNSTimeout(cfg)...)
+
+	return problems
+}
+
+// validateCheckDomain checks that domain is set to a fully qualified domain name.
+func validateCheckDomain(cfg checkConfig) []string {
+	domain, ok := cfg.string("domain")
+	if !ok {
+		return []string{"domain is required"}
+	}
+	if len(domain) > 253 || !domainNameRegexp.MatchString(domain) {
+		return []string{fmt.Sprintf("domain %q must be a fully qualified domain name such as example.com", domain)}
+	}
+	return nil
+}
+
+// validateDNSTimeout checks that timeout_seconds, if set, is between 1 and 30.
+func validateDNSTimeout(cfg checkConfig) []string {
+	timeout, ok, err := cfg.int("timeout_seconds")
+	switch {
+	case err != nil:
+		return []string{err.Error()}
+	case ok && (timeout < 1 || timeout > 30):
+		return []string{fmt.Sprintf("timeout_seconds must be between 1 and 30, got %d", timeout)}
+	}
+	return nil
+}
+
+// isNameserverAddress reports whether s is an IP address, or an IP address and
+// port such as "1.1.1.1:53" or "[2606:4700:4700::1111]:53".
+func isNameserverAddress(s string) bool {
+	if net.ParseIP(s) != nil {
+		return true
+	}
+	host, _, err := net.SplitHostPort(s)
+	return err == nil && net.ParseIP(host) != nil
+}
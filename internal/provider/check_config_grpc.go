@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// grpcMethodRegexp matches fully qualified gRPC method names such as
+// "acme.orders.v1.OrderService/GetOrder".
+var grpcMethodRegexp = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*\.)*[A-Za-z_][A-Za-z0-9_]*/[A-Za-z_][A-Za-z0-9_]*$`)
+
+// grpcMetadataKeyRegexp matches valid gRPC metadata keys.
+var grpcMetadataKeyRegexp = regexp.MustCompile(`^[0-9a-z_.-]+$`)
+
+// grpcStatusCodes lists the canonical gRPC status code names.
+var grpcStatusCodes = []string{
+	"OK", "CANCELLED", "UNKNOWN", "INVALID_ARGUMENT", "DEADLINE_EXCEEDED", "NOT_FOUND",
+	"ALREADY_EXISTS", "PERMISSION_DENIED", "RESOURCE_EXHAUSTED", "FAILED_PRECONDITION", "ABORTED",
+	"OUT_OF_RANGE", "UNIMPLEMENTED", "INTERNAL", "UNAVAILABLE", "DATA_LOSS", "UNAUTHENTICATED",
+}
+
+// grpcUnaryOnlyKeys may only be set when method is set.
+var grpcUnaryOnlyKeys = []string{"request", "descriptor_set", "expected_status", "response_assertions"}
+
+// validateGRPCConfig validates a grpc check. Without method the check calls the
+// standard grpc.health.v1.Health/Check for service (the whole server if empty)
+// and expects SERVING. With method it makes an arbitrary unary call with a JSON
+// request, resolving message types by server reflection or from descriptor_set
+// (a base64 FileDescriptorSet, e.g. from filebase64()).
+func validateGRPCConfig(cfg checkConfig) []string {
+	var problems []string
+
+	if !cfg.has("host") {
+		problems = append(problems, "host is required")
+	}
+	problems = append(problems, validatePort(cfg, "port", true)...)
+	problems = append(problems, validateCheckTLS(cfg)...)
+
+	if timeout, ok, err := cfg.int("timeout_seconds"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok && (timeout < 1 || timeout > 60) {
+		problems = append(problems, fmt.Sprintf("timeout_seconds must be between 1 and 60, got %d", timeout))
+	}
+
+	if metadata, ok, err := cfg.object("metadata"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok {
+		for _, key := range slices.Sorted(maps.Keys(metadata)) {
+			value := metadata[key]
+			if !grpcMetadataKeyRegexp.MatchString(key) || strings.HasPrefix(key, "grpc-") {
+				problems = append(problems, fmt.Sprintf("metadata key %q must be lowercase and must not start with grpc-", key))
+			}
+			if _, isString := value.(string); !isString {
+				problems = append(problems, fmt.Sprintf("metadata value of %q must be a string", key))
+			}
+		}
+	}
+
+	method, isUnary := cfg.string("method")
+	if !isUnary {
+		for _, key := range grpcUnaryOnlyKeys {
+			if cfg.has(key) {
+				problems = append(problems, key+" can only be set together with method")
+			}
+		}
+		return problems
+	}
+
+	if cfg.has("service") {
+		problems = append(problems, "service is only used by health checks; set either service or method, not both")
+	}
+	if !grpcMethodRegexp.MatchString(method) {
+		problems = append(problems, fmt.Sprintf("method %q must be a fully qualified method name such as package.Service/Method", method))
+	}
+
+	switch request := cfg["request"].(type) {
+	case nil, map[string]interface{}:
+	case string:
+		// From the config map, or a jsonencode()d body in config_json
+		var obj map[string]interface{}
+		if err := json.Unmarshal([]byte(request), &obj); err != nil {
+			problems = append(problems, "request must be a JSON object: "+err.Error())
+		}
+	default:
+		problems = append(problems, "request must be a JSON object")
+	}
+
+	if descriptorSet, ok := cfg.string("descriptor_set"); ok {
+		if decoded, err := base64.StdEncoding.DecodeString(descriptorSet); err != nil || len(decoded) == 0 {
+			problems = append(problems, "descriptor_set must be a base64-encoded FileDescriptorSet (use filebase64())")
+		}
+	}
+
+	if status, ok := cfg.string("expected_status"); ok && !slices.Contains(grpcStatusCodes, status) {
+		problems = append(problems, fmt.Sprintf("expected_status %q is not a gRPC status code (expected one of %s)", status, strings.Join(grpcStatusCodes, ", ")))
+	}
+
+	problems = append(problems, validateResponseAssertions(cfg, "response_assertions")...)
+
+	return problems
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+)
+
+// mailAuthMethods lists the Authentication-Results methods a smtp-imap check can
+// require to pass.
+var mailAuthMethods = []string{"spf", "dkim", "dmarc"}
+
+// validateSMTPIMAPConfig validates a smtp-imap check. Problems are errors for the
+// mail_deliverability block but only warnings for free-form config, which was
+// not validated at plan time before. The check sends a message from from_address to
+// to_address through smtp_host, waits up to max_wait_seconds for it to arrive in
+// the imap_host mailbox and reports the delivery latency. With
+// require_auth_pass it also asserts that the listed methods pass in the received
+// Authentication-Results header.
+func validateSMTPIMAPConfig(cfg checkConfig) []string {
+	var problems []string
+
+	for _, prefix := range []string{"smtp", "imap"} {
+		if !cfg.has(prefix + "_host") {
+			problems = append(problems, prefix+"_host is required")
+		}
+		problems = append(problems, validatePort(cfg, prefix+"_port", false)...)
+		if _, _, err := cfg.bool(prefix + "_use_tls"); err != nil {
+			problems = append(problems, err.Error())
+		}
+		if cfg.has(prefix+"_username") && !cfg.has(prefix+"_password") {
+			problems = append(problems, fmt.Sprintf("%[1]s_username requires %[1]s_password", prefix))
+		}
+	}
+
+	for _, key := range []string{"from_address", "to_address"} {
+		address, ok := cfg.string(key)
+		if !ok {
+			problems = append(problems, key+" is required")
+			continue
+		}
+		if parsed, err := mail.ParseAddress(address); err != nil || parsed.Address != address {
+			problems = append(problems, fmt.Sprintf("%s %q must be a plain email address such as monitoring@example.com", key, address))
+		}
+	}
+
+	if timeout, ok, err := cfg.int("timeout_seconds"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok && (timeout < 1 || timeout > 60) {
+		problems = append(problems, fmt.Sprintf("timeout_seconds must be between 1 and 60, got %d", timeout))
+	}
+	if wait, ok, err := cfg.int("max_wait_seconds"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok && (wait < 10 || wait > 900) {
+		problems = append(problems, fmt.Sprintf("max_wait_seconds must be between 10 and 900, got %d", wait))
+	}
+
+	if methods, _, err := cfg.stringList("require_auth_pass"); err != nil {
+		problems = append(problems, err.Error())
+	} else {
+		seen := map[string]bool{}
+		for _, method := range methods {
+			if !slices.Contains(mailAuthMethods, method) {
+				problems = append(problems, fmt.Sprintf("require_auth_pass entry %q is not supported (expected one of %s)", method, strings.Join(mailAuthMethods, ", ")))
+			}
+			if seen[method] {
+				problems = append(problems, fmt.Sprintf("require_auth_pass entry %q is listed more than once", method))
+			}
+			seen[method] = true
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// multistepStepValidators validate the configuration of multistep steps whose
+// type has a typed schema. Steps of other types are only validated by the API.
+var multistepStepValidators = map[string]func(cfg checkConfig) []string{
+	"websocket": validateWebSocketConfig,
+	"sse":       validateSSEConfig,
+}
+
+// validateMultistepConfig validates the steps of a multistep check that use a
+// typed step type. A step's configuration is read from its config object, or
+// from the step itself when the fields are set inline.
+func validateMultistepConfig(cfg checkConfig) []string {
+	if _, isString := cfg["steps"].(string); isString {
+		// Steps passed through the config map as a JSON string are left to the API
+		return nil
+	}
+	steps, _, err := cfg.objects("steps")
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var problems []string
+	for i, step := range steps {
+		stepType, _ := step.string("type")
+		validate, ok := multistepStepValidators[stepType]
+		if !ok {
+			continue
+		}
+
+		prefix := fmt.Sprintf("steps[%d]", i)
+		if name, ok := step.string("name"); ok {
+			prefix = fmt.Sprintf("%s (%s)", prefix, name)
+		}
+		stepConfig, nested, err := step.object("config")
+		if err != nil {
+			problems = append(problems, prefix+": "+err.Error())
+			continue
+		}
+		if !nested {
+			stepConfig = step
+		}
+		for _, problem := range validate(stepConfig) {
+			problems = append(problems, prefix+": "+problem)
+		}
+	}
+	return problems
+}
+
+// validateWebSocketConfig validates a websocket check: it connects to url (ws://
+// or wss://), optionally sends message, and expects a reply matching
+// expected_contains or expected_regex within reply_timeout_ms. Without an
+// expectation the check only verifies the upgrade.
+func validateWebSocketConfig(cfg checkConfig) []string {
+	var problems []string
+
+	problems = append(problems, validateStreamURL(cfg, "ws", "wss")...)
+	problems = append(problems, validateStreamHeaders(cfg)...)
+	problems = append(problems, validateStreamExpectation(cfg)...)
+	problems = append(problems, validateMilliseconds(cfg, "connect_timeout_ms", 60000)...)
+	problems = append(problems, validateMilliseconds(cfg, "reply_timeout_ms", 60000)...)
+
+	switch subprotocols := cfg["subprotocols"].(type) {
+	case nil, string:
+		// Unset, or comma-separated from the config map
+	case []interface{}:
+		for _, p := range subprotocols {
+			if s, ok := p.(string); !ok || s == "" {
+				problems = append(problems, "subprotocols must be a list of non-empty strings")
+				break
+			}
+		}
+	default:
+		problems = append(problems, "subprotocols must be a list of strings")
+	}
+
+	if cfg.has("reply_timeout_ms") && !cfg.has("expected_contains") && !cfg.has("expected_regex") {
+		problems = append(problems, "reply_timeout_ms requires expected_contains or expected_regex")
+	}
+	if _, _, err := cfg.bool("tls_skip_verify"); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	return problems
+}
+
+// validateSSEConfig validates an sse (Server-Sent Events) check: it subscribes to
+// url and expects an event, optionally of type event and with data matching
+// expected_contains or expected_regex, within timeout_ms.
+func validateSSEConfig(cfg checkConfig) []string {
+	var problems []string
+
+	problems = append(problems, validateStreamURL(cfg, "http", "https")...)
+	problems = append(problems, validateStreamHeaders(cfg)...)
+	problems = append(problems, validateStreamExpectation(cfg)...)
+	problems = append(problems, validateMilliseconds(cfg, "timeout_ms", 300000)...)
+
+	if _, _, err := cfg.bool("tls_skip_verify"); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	return problems
+}
+
+// validateStreamURL checks that url is set and uses one of the given schemes.
+// Templated URLs (e.g. wss://{{host}}/live in a multistep check) are only
+// resolved at run time, so only their scheme is checked.
+func validateStreamURL(cfg checkConfig, schemes ...string) []string {
+	raw, ok := cfg.string("url")
+	if !ok {
+		return []string{"url is required"}
+	}
+	if strings.Contains(raw, "{{") {
+		scheme, _, found := strings.Cut(raw, "://")
+		if !found || strings.Contains(scheme, "{{") || slices.Contains(schemes, scheme) {
+			return nil
+		}
+		return []string{fmt.Sprintf("url %q must use the %s:// or %s:// scheme", raw, schemes[0], schemes[1])}
+	}
+	u, err := url.Parse(raw)
+	if err != nil || !slices.Contains(schemes, u.Scheme) || u.Host == "" {
+		return []string{fmt.Sprintf("url %q must be an absolute %s:// or %s:// URL", raw, schemes[0], schemes[1])}
+	}
+	return nil
+}
+
+// validateStreamHeaders checks that headers, if set, map names to strings.
+func validateStreamHeaders(cfg checkConfig) []string {
+	headers, _, err := cfg.object("headers")
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var problems []string
+	for _, name := range slices.Sorted(maps.Keys(headers)) {
+		if _, ok := headers[name].(string); !ok {
+			problems = append(problems, fmt.Sprintf("headers value of %q must be a string", name))
+		}
+	}
+	return problems
+}
+
+// validateStreamExpectation checks that at most one of expected_contains and
+// expected_regex is set, and that the regex compiles.
+func validateStreamExpectation(cfg checkConfig) []string {
+	var problems []string
+	if cfg.has("expected_contains") && cfg.has("expected_regex") {
+		problems = append(problems, "set either expected_contains or expected_regex, not both")
+	}
+	if pattern, ok := cfg.string("expected_regex"); ok {
+		if _, err := regexp.Compile(pattern); err != nil {
+			problems = append(problems, fmt.Sprintf("expected_regex %q is invalid: %s", pattern, err))
+		}
+	}
+	return problems
+}
+
+// validateMilliseconds checks that key, if set, is a duration between 1 and max
+// milliseconds.
+func validateMilliseconds(cfg checkConfig, key string, max int64) []string {
+	ms, ok, err := cfg.int(key)
+	switch {
+	case err != nil:
+		return []string{err.Error()}
+	case ok && (ms < 1 || ms > max):
+		return []string{fmt.Sprintf("%s must be between 1 and %d, got %d", key, max, ms)}
+	}
+	return nil
+}
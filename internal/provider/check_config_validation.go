@@ -0,0 +1,399 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// checkConfig is a check's configuration as sent to the API. Values decoded from
+// the config map are always strings; values decoded from config_json keep their
+// JSON types, and values from typed blocks such as mail_deliverability their Go
+// types, so the accessors below accept all of them.
+type checkConfig map[string]interface{}
+
+// has reports whether key is set to a non-empty value.
+func (c checkConfig) has(key string) bool {
+	v, ok := c[key]
+	if !ok || v == nil {
+		return false
+	}
+	if s, ok := v.(string); ok {
+		return s != ""
+	}
+	return true
+}
+
+// string returns the value of key as a string.
+func (c checkConfig) string(key string) (string, bool) {
+	v, ok := c[key].(string)
+	return v, ok && v != ""
+}
+
+// int returns the value of key as an integer. ok is false if the key is unset.
+func (c checkConfig) int(key string) (value int64, ok bool, err error) {
+	if !c.has(key) {
+		return 0, false, nil
+	}
+	switch v := c[key].(type) {
+	case int64:
+		return v, true, nil
+	case float64:
+		if v != float64(int64(v)) {
+			return 0, true, fmt.Errorf("%s must be a whole number, got %v", key, v)
+		}
+		return int64(v), true, nil
+	case string:
+		n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return 0, true, fmt.Errorf("%s must be a whole number, got %q", key, v)
+		}
+		return n, true, nil
+	default:
+		return 0, true, fmt.Errorf("%s must be a whole number", key)
+	}
+}
+
+// float returns the value of key as a number. ok is false if the key is unset.
+func (c checkConfig) float(key string) (value float64, ok bool, err error) {
+	if !c.has(key) {
+		return 0, false, nil
+	}
+	switch v := c[key].(type) {
+	case float64:
+		return v, true, nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, true, fmt.Errorf("%s must be a number, got %q", key, v)
+		}
+		return f, true, nil
+	default:
+		return 0, true, fmt.Errorf("%s must be a number", key)
+	}
+}
+
+// bool returns the value of key as a boolean. ok is false if the key is unset.
+func (c checkConfig) bool(key string) (value bool, ok bool, err error) {
+	if !c.has(key) {
+		return false, false, nil
+	}
+	switch v := c[key].(type) {
+	case bool:
+		return v, true, nil
+	case string:
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return false, true, fmt.Errorf("%s must be true or false, got %q", key, v)
+		}
+		return b, true, nil
+	default:
+		return false, true, fmt.Errorf("%s must be true or false", key)
+	}
+}
+
+// objects returns the value of key as a list of objects, as decoded from
+// config_json. ok is false if the key is unset.
+func (c checkConfig) objects(key string) (value []checkConfig, ok bool, err error) {
+	if !c.has(key) {
+		return nil, false, nil
+	}
+	list, isList := c[key].([]interface{})
+	if !isList {
+		return nil, true, fmt.Errorf("%s must be a list of objects (use config_json)", key)
+	}
+	for i, item := range list {
+		obj, isObject := item.(map[string]interface{})
+		if !isObject {
+			return nil, true, fmt.Errorf("%s[%d] must be an object", key, i)
+		}
+		value = append(value, checkConfig(obj))
+	}
+	return value, true, nil
+}
+
+// object returns the value of key as an object, as decoded from config_json. ok
+// is false if the key is unset.
+func (c checkConfig) object(key string) (value checkConfig, ok bool, err error) {
+	if !c.has(key) {
+		return nil, false, nil
+	}
+	obj, isObject := c[key].(map[string]interface{})
+	if !isObject {
+		return nil, true, fmt.Errorf("%s must be an object (use config_json)", key)
+	}
+	return checkConfig(obj), true, nil
+}
+
+// stringList returns the value of key as a list of strings, either a list from
+// config_json or a jsonencode()d list from the config map. ok is false if the key
+// is unset.
+func (c checkConfig) stringList(key string) (value []string, ok bool, err error) {
+	if !c.has(key) {
+		return nil, false, nil
+	}
+	list, isList := c[key].([]interface{})
+	if s, isString := c[key].(string); isString {
+		if err := json.Unmarshal([]byte(s), &list); err != nil {
+			return nil, true, fmt.Errorf("%s must be a list of strings (use jsonencode())", key)
+		}
+	} else if !isList {
+		return nil, true, fmt.Errorf("%s must be a list of strings", key)
+	}
+	for i, item := range list {
+		s, isString := item.(string)
+		if !isString {
+			return nil, true, fmt.Errorf("%s[%d] must be a string", key, i)
+		}
+		value = append(value, s)
+	}
+	return value, true, nil
+}
+
+// validatePort checks that key is a valid TCP/UDP port number.
+func validatePort(cfg checkConfig, key string, required bool) []string {
+	port, ok, err := cfg.int(key)
+	switch {
+	case err != nil:
+		return []string{err.Error()}
+	case !ok && required:
+		return []string{key + " is required"}
+	case ok && (port < 1 || port > 65535):
+		return []string{fmt.Sprintf("%s must be between 1 and 65535, got %d", key, port)}
+	}
+	return nil
+}
+
+// validateCheckTLS validates the TLS options shared by check types that connect to
+// a TLS-capable server: tls, tls_skip_verify, tls_server_name and PEM-encoded
+// ca_certificate, client_certificate and client_key for mTLS.
+func validateCheckTLS(cfg checkConfig) []string {
+	var problems []string
+
+	useTLS, _, err := cfg.bool("tls")
+	if err != nil {
+		problems = append(problems, err.Error())
+	}
+	if _, _, err := cfg.bool("tls_skip_verify"); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	for _, key := range []string{"ca_certificate", "client_certificate", "client_key"} {
+		value, ok := cfg.string(key)
+		if !ok {
+			continue
+		}
+		if !strings.Contains(value, "-----BEGIN ") {
+			problems = append(problems, key+" must be PEM-encoded")
+		}
+		if !useTLS {
+			problems = append(problems, key+" requires tls = true")
+		}
+	}
+	if cfg.has("client_certificate") != cfg.has("client_key") {
+		problems = append(problems, "client_certificate and client_key must be set together for mTLS")
+	}
+	if cfg.has("tls_server_name") && !useTLS {
+		problems = append(problems, "tls_server_name requires tls = true")
+	}
+
+	return problems
+}
+
+// checkConfigValidators validate the configuration of individual check types at
+// plan time. Each returns a list of problems; types without an entry are only
+// validated by the API when the check is created or updated.
+var checkConfigValidators = map[string]func(cfg checkConfig) []string{
+	"heartbeat": validateHeartbeatConfig,
+	"browser":   validateBrowserConfig,
+	"grpc":      validateGRPCConfig,
+	"websocket": validateWebSocketConfig,
+	"sse":       validateSSEConfig,
+	"multistep": validateMultistepConfig,
+	"postgres":  validateSQLConfig,
+	"mysql":     validateSQLConfig,
+	"redis":     validateRedisConfig,
+	"mongodb":   validateMongoDBConfig,
+
+	"domain_expiry":   validateDomainExpiryConfig,
+	"dns_propagation": validateDNSPropagationConfig,
+}
+
+// validateCheckConfig returns the configuration problems of a check of the given type.
+func validateCheckConfig(checkType string, cfg checkConfig) []string {
+	validate, ok := checkConfigValidators[checkType]
+	if !ok {
+		return nil
+	}
+	return validate(cfg)
+}
+
+// validateHeartbeatConfig validates a heartbeat (push) check. The monitored job
+// pings the check's ping_url; the check fails when no ping arrives within
+// period_seconds (or by the next cron occurrence) plus grace_seconds.
+func validateHeartbeatConfig(cfg checkConfig) []string {
+	var problems []string
+
+	period, hasPeriod, err := cfg.int("period_seconds")
+	if err != nil {
+		problems = append(problems, err.Error())
+	} else if hasPeriod && period < 60 {
+		problems = append(problems, fmt.Sprintf("period_seconds must be at least 60, got %d", period))
+	}
+
+	cron, hasCron := cfg.string("cron")
+	if hasCron {
+		if err := validateCronExpression(cron); err != nil {
+			problems = append(problems, fmt.Sprintf("cron %q is invalid: %s", cron, err))
+		}
+	}
+
+	switch {
+	case hasPeriod && hasCron:
+		problems = append(problems, "set either period_seconds or cron, not both")
+	case !hasPeriod && !hasCron:
+		problems = append(problems, "one of period_seconds or cron is required")
+	}
+
+	if grace, ok, err := cfg.int("grace_seconds"); err != nil {
+		problems = append(problems, err.Error())
+	} else if ok && grace < 0 {
+		problems = append(problems, fmt.Sprintf("grace_seconds must not be negative, got %d", grace))
+	}
+
+	if tz, ok := cfg.string("timezone"); ok {
+		if !hasCron {
+			problems = append(problems, "timezone can only be set together with cron")
+		}
+		if _, err := time.LoadLocation(tz); err != nil || tz == "Local" {
+			problems = append(problems, fmt.Sprintf("timezone %q is not a valid IANA time zone name", tz))
+		}
+	}
+
+	return problems
+}
+
+// cronFields describes the five fields of a standard cron expression.
+var cronFields = []struct {
+	name     string
+	min, max int
+	names    []string // Optional names for values starting at min
+}{
+	{"minute", 0, 59, nil},
+	{"hour", 0, 23, nil},
+	{"day of month", 1, 31, nil},
+	{"month", 1, 12, []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{"day of week", 0, 7, []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// cronMacros lists the supported shorthand schedules.
+var cronMacros = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+// validateCronExpression checks a standard five-field cron expression
+// (minute hour day-of-month month day-of-week) or a macro such as @daily.
+func validateCronExpression(expr string) error {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		for _, macro := range cronMacros {
+			if expr == macro {
+				return nil
+			}
+		}
+		return fmt.Errorf("unknown macro (expected one of %s)", strings.Join(cronMacros, ", "))
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
+
+	for i, field := range fields {
+		spec := cronFields[i]
+		for _, part := range strings.Split(field, ",") {
+			rangePart, step, hasStep := strings.Cut(part, "/")
+			if hasStep {
+				n, err := strconv.Atoi(step)
+				if err != nil || n < 1 {
+					return fmt.Errorf("invalid step %q in %s field", step, spec.name)
+				}
+			}
+			if rangePart == "*" {
+				continue
+			}
+
+			low, high, isRange := strings.Cut(rangePart, "-")
+			lowValue, err := cronValue(low, spec.min, spec.max, spec.names)
+			if err != nil {
+				return fmt.Errorf("%s field: %w", spec.name, err)
+			}
+			if isRange {
+				highValue, err := cronValue(high, spec.min, spec.max, spec.names)
+				if err != nil {
+					return fmt.Errorf("%s field: %w", spec.name, err)
+				}
+				if highValue < lowValue {
+					return fmt.Errorf("%s field: range %q is backwards", spec.name, rangePart)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// cronValue parses a single cron field value, either a number or a name.
+func cronValue(s string, min, max int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(s, name) {
+			return min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", n, min, max)
+	}
+	return n, nil
+}
+
+// validateResponseAssertions validates a list of response assertions under key.
+// Each assertion selects a value with jsonpath (e.g. "$.status") and compares it
+// with exactly one of equals, contains or regex, like the extracts of multistep
+// steps.
+func validateResponseAssertions(cfg checkConfig, key string) []string {
+	assertions, _, err := cfg.objects(key)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var problems []string
+	for i, assertion := range assertions {
+		prefix := fmt.Sprintf("%s[%d]", key, i)
+		if path, ok := assertion.string("jsonpath"); !ok {
+			problems = append(problems, prefix+": jsonpath is required")
+		} else if !strings.HasPrefix(path, "$") {
+			problems = append(problems, fmt.Sprintf("%s: jsonpath %q must start with $", prefix, path))
+		}
+
+		operators := 0
+		for _, op := range []string{"equals", "contains", "regex"} {
+			if _, set := assertion[op]; set {
+				operators++
+			}
+		}
+		if operators != 1 {
+			problems = append(problems, prefix+": set exactly one of equals, contains or regex")
+		}
+		if pattern, ok := assertion.string("regex"); ok {
+			if _, err := regexp.Compile(pattern); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: regex %q is invalid: %s", prefix, pattern, err))
+			}
+		}
+	}
+	return problems
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// checkMailDeliverabilityModel maps the mail_deliverability block.
+type checkMailDeliverabilityModel struct {
+	FromAddress     types.String          `tfsdk:"from_address"`
+	ToAddress       types.String          `tfsdk:"to_address"`
+	Subject         types.String          `tfsdk:"subject"`
+	Body            types.String          `tfsdk:"body"`
+	MaxWaitSeconds  types.Int64           `tfsdk:"max_wait_seconds"`
+	TimeoutSeconds  types.Int64           `tfsdk:"timeout_seconds"`
+	RequireAuthPass types.Set             `tfsdk:"require_auth_pass"`
+	SMTP            *checkMailServerModel `tfsdk:"smtp"`
+	IMAP            *checkMailServerModel `tfsdk:"imap"`
+}
+
+// checkMailServerModel maps the smtp and imap blocks within mail_deliverability.
+type checkMailServerModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	UseTLS   types.Bool   `tfsdk:"use_tls"`
+}
+
+// checkMailDeliverabilityBlock returns the schema of the mail_deliverability block.
+func checkMailDeliverabilityBlock() schema.SingleNestedBlock {
+	serverBlock := func(protocol string) schema.SingleNestedBlock {
+		return schema.SingleNestedBlock{
+			Description: protocol + " server. Required.",
+			Attributes: map[string]schema.Attribute{
+				"host": schema.StringAttribute{
+					Description: protocol + " server host name.",
+					Required:    true,
+				},
+				"port": schema.Int64Attribute{
+					Description: protocol + " server port.",
+					Optional:    true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65535),
+					},
+				},
+				"username": schema.StringAttribute{
+					Description: "User to log in as.",
+					Optional:    true,
+				},
+				"password": schema.StringAttribute{
+					Description: "Password of username. Cannot be re-read from the API; changes made outside Terraform are detected through config_hash.",
+					Optional:    true,
+					Sensitive:   true,
+				},
+				"use_tls": schema.BoolAttribute{
+					Description: "Whether to connect with TLS (STARTTLS or implicit, depending on port).",
+					Optional:    true,
+				},
+			},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: "smtp-imap checks only: typed configuration of the mail deliverability round trip, instead of config or config_json. " +
+			"The check sends a message through the smtp server, waits for it in the imap mailbox and reports the delivery latency. " +
+			"With require_auth_pass it also asserts that SPF, DKIM and DMARC pass in the received Authentication-Results header.",
+		Attributes: map[string]schema.Attribute{
+			"from_address": schema.StringAttribute{
+				Description: "Sender address of the test message.",
+				Required:    true,
+			},
+			"to_address": schema.StringAttribute{
+				Description: "Recipient address of the test message, delivered to the imap mailbox.",
+				Required:    true,
+			},
+			"subject": schema.StringAttribute{
+				Description: "Subject of the test message. {{message_id}} is replaced by a unique ID.",
+				Optional:    true,
+			},
+			"body": schema.StringAttribute{
+				Description: "Body of the test message.",
+				Optional:    true,
+			},
+			"max_wait_seconds": schema.Int64Attribute{
+				Description: "How long to wait for the message to arrive (10-900). The check fails if the delivery latency exceeds it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(10, 900),
+				},
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Timeout of each SMTP and IMAP connection (1-60).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60),
+				},
+			},
+			"require_auth_pass": schema.SetAttribute{
+				Description: "Authentication-Results methods that must pass on the received message: spf, dkim, or dmarc.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(mailAuthMethods...)),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"smtp": serverBlock("SMTP"),
+			"imap": serverBlock("IMAP"),
+		},
+	}
+}
+
+// expandCheckMailDeliverability converts the mail_deliverability block to the
+// smtp-imap check configuration, using the same keys as free-form config so the
+// passwords are covered by config_hash. known is false if any value is unknown.
+func expandCheckMailDeliverability(m *checkMailDeliverabilityModel) (cfg checkConfig, known bool) {
+	cfg = checkConfig{}
+	known = true
+
+	set := func(key string, value attr.Value) {
+		switch {
+		case value.IsUnknown():
+			known = false
+		case value.IsNull():
+		default:
+			switch v := value.(type) {
+			case types.String:
+				cfg[key] = v.ValueString()
+			case types.Int64:
+				cfg[key] = v.ValueInt64()
+			case types.Bool:
+				cfg[key] = v.ValueBool()
+			}
+		}
+	}
+
+	set("from_address", m.FromAddress)
+	set("to_address", m.ToAddress)
+	set("subject", m.Subject)
+	set("body", m.Body)
+	set("max_wait_seconds", m.MaxWaitSeconds)
+	set("timeout_seconds", m.TimeoutSeconds)
+	for prefix, server := range map[string]*checkMailServerModel{"smtp": m.SMTP, "imap": m.IMAP} {
+		if server == nil {
+			continue
+		}
+		set(prefix+"_host", server.Host)
+		set(prefix+"_port", server.Port)
+		set(prefix+"_username", server.Username)
+		set(prefix+"_password", server.Password)
+		set(prefix+"_use_tls", server.UseTLS)
+	}
+
+	switch {
+	case m.RequireAuthPass.IsUnknown():
+		known = false
+	case !m.RequireAuthPass.IsNull():
+		methods := []interface{}{}
+		for _, element := range m.RequireAuthPass.Elements() {
+			method, ok := element.(types.String)
+			if !ok || method.IsUnknown() {
+				known = false
+				continue
+			}
+			methods = append(methods, method.ValueString())
+		}
+		cfg["require_auth_pass"] = methods
+	}
+
+	return cfg, known
+}
+
+// validateCheckMailDeliverability validates the mail_deliverability block: it is
+// only valid on smtp-imap checks, replaces config and config_json, and needs both
+// the smtp and imap blocks.
+func validateCheckMailDeliverability(config checkResourceModel, diags *diag.Diagnostics) {
+	blockPath := path.Root("mail_deliverability")
+	valid := true
+
+	if !config.Type.IsNull() && !config.Type.IsUnknown() && config.Type.ValueString() != "smtp-imap" {
+		diags.AddAttributeError(
+			blockPath,
+			"Unexpected mail_deliverability",
+			fmt.Sprintf("mail_deliverability can only be set on smtp-imap checks, not on %s checks.", config.Type.ValueString()),
+		)
+		valid = false
+	}
+	if !config.Config.IsNull() || !config.ConfigJSON.IsNull() {
+		diags.AddAttributeError(
+			blockPath,
+			"Conflicting Check Configuration",
+			"Set either mail_deliverability or config/config_json, not both.",
+		)
+		valid = false
+	}
+	if config.MailDeliverability.SMTP == nil {
+		diags.AddAttributeError(blockPath, "Missing Mail Server", "mail_deliverability needs an smtp block.")
+		valid = false
+	}
+	if config.MailDeliverability.IMAP == nil {
+		diags.AddAttributeError(blockPath, "Missing Mail Server", "mail_deliverability needs an imap block.")
+		valid = false
+	}
+	if !valid {
+		return
+	}
+
+	cfg, known := expandCheckMailDeliverability(config.MailDeliverability)
+	if !known {
+		// Validated again once all values are known
+		return
+	}
+	for _, problem := range validateSMTPIMAPConfig(cfg) {
+		diags.AddAttributeError(
+			blockPath,
+			"Invalid Check Configuration",
+			fmt.Sprintf("Invalid configuration for smtp-imap check: %s.", problem),
+		)
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testMailDeliverability() *checkMailDeliverabilityModel {
+	return &checkMailDeliverabilityModel{
+		FromAddress:     types.StringValue("monitoring@example.com"),
+		ToAddress:       types.StringValue("inbox@example.com"),
+		Subject:         types.StringNull(),
+		Body:            types.StringValue("Deliverability probe"),
+		MaxWaitSeconds:  types.Int64Value(120),
+		TimeoutSeconds:  types.Int64Null(),
+		RequireAuthPass: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("dmarc")}),
+		SMTP: &checkMailServerModel{
+			Host:     types.StringValue("smtp.example.com"),
+			Port:     types.Int64Value(587),
+			Username: types.StringValue("monitoring@example.com"),
+			Password: types.StringValue("smtp-secret"),
+			UseTLS:   types.BoolValue(true),
+		},
+		IMAP: &checkMailServerModel{
+			Host:     types.StringValue("imap.example.com"),
+			Port:     types.Int64Null(),
+			Username: types.StringValue("inbox@example.com"),
+			Password: types.StringValue("imap-secret"),
+			UseTLS:   types.BoolNull(),
+		},
+	}
+}
+
+func TestExpandCheckMailDeliverability(t *testing.T) {
+	cfg, known := expandCheckMailDeliverability(testMailDeliverability())
+	if !known {
+		t.Fatal("expected all values to be known")
+	}
+
+	want := checkConfig{
+		"from_address":      "monitoring@example.com",
+		"to_address":        "inbox@example.com",
+		"body":              "Deliverability probe",
+		"max_wait_seconds":  int64(120),
+		"require_auth_pass": []interface{}{"dmarc"},
+		"smtp_host":         "smtp.example.com",
+		"smtp_port":         int64(587),
+		"smtp_username":     "monitoring@example.com",
+		"smtp_password":     "smtp-secret",
+		"smtp_use_tls":      true,
+		"imap_host":         "imap.example.com",
+		"imap_username":     "inbox@example.com",
+		"imap_password":     "imap-secret",
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("got %v, want %v", cfg, want)
+	}
+	if problems := validateSMTPIMAPConfig(cfg); len(problems) != 0 {
+		t.Errorf("expected the expanded config to be valid, got: %v", problems)
+	}
+
+	unknown := testMailDeliverability()
+	unknown.SMTP.Password = types.StringUnknown()
+	if _, known := expandCheckMailDeliverability(unknown); known {
+		t.Error("expected an unknown password to make the config unknown")
+	}
+}
+
+func TestValidateCheckMailDeliverability(t *testing.T) {
+	testCases := []struct {
+		name    string
+		modify  func(*checkResourceModel)
+		wantErr string
+	}{
+		{"valid", func(*checkResourceModel) {}, ""},
+		{"other check type", func(m *checkResourceModel) { m.Type = types.StringValue("https") }, "can only be set on smtp-imap checks"},
+		{"together with config_json", func(m *checkResourceModel) { m.ConfigJSON = types.StringValue("{}") }, "not both"},
+		{"missing imap block", func(m *checkResourceModel) { m.MailDeliverability.IMAP = nil }, "needs an imap block"},
+		{"username without password", func(m *checkResourceModel) {
+			m.MailDeliverability.IMAP.Password = types.StringNull()
+		}, "imap_username requires imap_password"},
+		{"unknown address", func(m *checkResourceModel) {
+			// Validated again once known
+			m.MailDeliverability.ToAddress = types.StringUnknown()
+			m.MailDeliverability.IMAP.Password = types.StringNull()
+		}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := checkResourceModel{
+				Type:               types.StringValue("smtp-imap"),
+				Config:             types.MapNull(types.StringType),
+				ConfigJSON:         types.StringNull(),
+				MailDeliverability: testMailDeliverability(),
+			}
+			tc.modify(&config)
+
+			var diags diag.Diagnostics
+			validateCheckMailDeliverability(config, &diags)
+
+			if tc.wantErr == "" {
+				if diags.HasError() {
+					t.Errorf("expected no errors, got: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tc.wantErr) {
+				t.Errorf("expected an error containing %q, got: %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestCheckResource_ValidateConfigFreeFormMail(t *testing.T) {
+	h := newResourceHarness(t, NewCheckResource(), nil)
+
+	// Free-form configurations predate plan-time validation and must keep planning
+	diags := h.validateConfig(checkResourceModel{
+		Name:            types.StringValue("mail"),
+		Type:            types.StringValue("smtp-imap"),
+		Config:          types.MapNull(types.StringType),
+		ConfigJSON:      types.StringValue(`{"smtp_host":"smtp.example.com","smtp_username":"monitoring","smtp_password":"secret","imap_host":"imap.example.com"}`),
+		IntervalSeconds: types.Int64Value(300),
+		Regions:         types.SetNull(types.StringType),
+		DependsOn:       types.SetNull(types.StringType),
+		Tags:            types.SetNull(types.StringType),
+		RunOnChange:     types.MapNull(types.StringType),
+	})
+
+	if diags.HasError() {
+		t.Fatalf("expected only warnings, got: %v", diags)
+	}
+	var summaries []string
+	for _, d := range diags.Warnings() {
+		summaries = append(summaries, d.Summary())
+	}
+	for _, want := range []string{"Possibly Invalid Check Configuration", "Free-form Mail Passwords"} {
+		if !slices.Contains(summaries, want) {
+			t.Errorf("expected a %q warning, got: %v", want, summaries)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// applyCheckPause pauses or resumes a check when paused_until changed from prior
+// to planned. Removing paused_until, or moving it to a time that has already
+// passed, resumes the check. It returns the updated check, or nil if nothing was
+// done or the call failed; on failure planned is reset to prior so the change is
+// retried.
+func applyCheckPause(c *client.Client, checkID string, planned *types.String, prior types.String, diags *diag.Diagnostics) *client.Check {
+	if planned.IsUnknown() || (planned.IsNull() && prior.IsNull()) || samePausedUntil(planned.ValueString(), prior) {
+		return nil
+	}
+
+	if !planned.IsNull() {
+		until, _ := time.Parse(time.RFC3339, planned.ValueString())
+		if until.After(time.Now()) {
+			check, err := c.PauseCheck(checkID, planned.ValueString())
+			if err != nil {
+				diags.AddError(
+					"Error Pausing Check",
+					"Could not pause check ID "+checkID+", unexpected error: "+err.Error(),
+				)
+				*planned = prior
+				return nil
+			}
+			return check
+		}
+	}
+
+	check, err := c.ResumeCheck(checkID)
+	if err != nil {
+		diags.AddError(
+			"Error Resuming Check",
+			"Could not resume check ID "+checkID+", unexpected error: "+err.Error(),
+		)
+		*planned = prior
+		return nil
+	}
+	return check
+}
+
+// samePausedUntil reports whether a timestamp denotes the same instant as a
+// paused_until value, ignoring formatting differences such as time zone offsets.
+func samePausedUntil(timestamp string, value types.String) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return false
+	}
+	a, errA := time.Parse(time.RFC3339, timestamp)
+	b, errB := time.Parse(time.RFC3339, value.ValueString())
+	if errA != nil || errB != nil {
+		return timestamp == value.ValueString()
+	}
+	return a.Equal(b)
+}
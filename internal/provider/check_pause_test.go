@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+func TestApplyCheckPause(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/v1/checks/chk-1/pause":
+			fmt.Fprint(w, `{"data":{"id":"chk-1","enabled":true,"paused_until":"2999-01-01T00:00:00Z"}}`)
+		case "/v1/checks/chk-1/resume":
+			fmt.Fprint(w, `{"data":{"id":"chk-1","enabled":true}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	future := types.StringValue("2999-01-01T00:00:00Z")
+	testCases := []struct {
+		name     string
+		planned  types.String
+		prior    types.String
+		wantCall string
+	}{
+		{"pause", future, types.StringNull(), "POST /v1/checks/chk-1/pause"},
+		{"unchanged", future, types.StringValue("2999-01-01T01:00:00+01:00"), ""},
+		{"resume", types.StringNull(), future, "POST /v1/checks/chk-1/resume"},
+		{"not paused", types.StringNull(), types.StringNull(), ""},
+		{"moved to the past", types.StringValue("2000-01-01T00:00:00Z"), future, "POST /v1/checks/chk-1/resume"},
+		{"unchanged in the past", types.StringValue("2000-01-01T00:00:00Z"), types.StringValue("2000-01-01T00:00:00Z"), ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = nil
+			var diags diag.Diagnostics
+			planned := tc.planned
+			check := applyCheckPause(c, "chk-1", &planned, tc.prior, &diags)
+
+			if diags.HasError() || diags.WarningsCount() != 0 {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if tc.wantCall == "" {
+				if len(calls) != 0 || check != nil {
+					t.Errorf("expected no API call, got %v", calls)
+				}
+				return
+			}
+			if len(calls) != 1 || calls[0] != tc.wantCall {
+				t.Errorf("expected %q, got %v", tc.wantCall, calls)
+			}
+			if check == nil {
+				t.Fatal("expected the updated check")
+			}
+		})
+	}
+}
+
+func TestCheckIsPaused(t *testing.T) {
+	until := "2026-06-01T12:00:00Z"
+	check := client.Check{PausedUntil: &until}
+
+	if !check.IsPaused(time.Date(2026, 6, 1, 11, 0, 0, 0, time.UTC)) {
+		t.Error("expected the check to be paused before paused_until")
+	}
+	if check.IsPaused(time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Error("expected the pause to have lapsed at paused_until")
+	}
+	if (&client.Check{}).IsPaused(time.Now()) {
+		t.Error("expected a check without paused_until not to be paused")
+	}
+}
+
+func TestCheckResource_ReadKeepsConfiguredPause(t *testing.T) {
+	// The pause was extended in the dashboard
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/checks/chk-1" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"chk-1","org_id":"org-1","name":"api","type":"https","config":{"url":"https://example.com"},`+
+			`"interval_seconds":300,"regions":["us-east-1"],"enabled":true,"paused_until":"2999-02-01T00:00:00Z"}}`)
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newResourceHarness(t, NewCheckResource(), c)
+
+	configured := types.StringValue("2999-01-01T00:00:00Z")
+	state, diags := h.read(h.state(checkResourceModel{
+		ID:              types.StringValue("chk-1"),
+		Name:            types.StringValue("api"),
+		Type:            types.StringValue("https"),
+		Config:          types.MapValueMust(types.StringType, map[string]attr.Value{"url": types.StringValue("https://example.com")}),
+		IntervalSeconds: types.Int64Value(300),
+		Regions:         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("us-east-1")}),
+		Enabled:         types.BoolValue(true),
+		DependsOn:       types.SetNull(types.StringType),
+		Tags:            types.SetNull(types.StringType),
+		PausedUntil:     configured,
+		RunOnChange:     types.MapNull(types.StringType),
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var model checkResourceModel
+	h.model(state, &model)
+	if !model.PausedUntil.Equal(configured) {
+		t.Errorf("expected the configured paused_until to be kept, got %s", model.PausedUntil)
+	}
+	if !model.Paused.ValueBool() {
+		t.Error("expected paused to report the extended pause")
+	}
+}
@@ -4,8 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,15 +18,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/quismon/terraform-provider-quismon/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &checkResource{}
-	_ resource.ResourceWithConfigure   = &checkResource{}
-	_ resource.ResourceWithImportState = &checkResource{}
+	_ resource.Resource                   = &checkResource{}
+	_ resource.ResourceWithConfigure      = &checkResource{}
+	_ resource.ResourceWithImportState    = &checkResource{}
+	_ resource.ResourceWithModifyPlan     = &checkResource{}
+	_ resource.ResourceWithValidateConfig = &checkResource{}
 )
 
 // NewCheckResource is a helper function to simplify the provider implementation.
@@ -36,27 +45,35 @@ type checkResource struct {
 
 // checkResourceModel maps the resource schema data.
 type checkResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	OrgID               types.String `tfsdk:"org_id"`
-	Name                types.String `tfsdk:"name"`
-	Type                types.String `tfsdk:"type"`
-	Config              types.Map    `tfsdk:"config"`
-	ConfigJSON          types.String `tfsdk:"config_json"`
-	ConfigHash          types.String `tfsdk:"config_hash"`
-	IntervalSeconds     types.Int64  `tfsdk:"interval_seconds"`
-	Regions             types.Set    `tfsdk:"regions"`
-	Enabled             types.Bool   `tfsdk:"enabled"`
-	Inverted            types.Bool   `tfsdk:"inverted"`
-	SimultaneousRegions types.Bool   `tfsdk:"simultaneous_regions"`
-	RecheckOnFailure    types.Bool   `tfsdk:"recheck_on_failure"`
-	ShowOnStatusPage    types.Bool   `tfsdk:"show_on_status_page"`
-	ExpiresAfterSeconds types.Int64 `tfsdk:"expires_after_seconds"`
-	DependsOn           types.Set   `tfsdk:"check_dependencies"`
-	IaCLocked           types.Bool  `tfsdk:"iac_locked"`
-	HealthStatus        types.String `tfsdk:"health_status"`
-	LastChecked         types.String `tfsdk:"last_checked"`
-	CreatedAt           types.String `tfsdk:"created_at"`
-	UpdatedAt           types.String `tfsdk:"updated_at"`
+	ID                  types.String                  `tfsdk:"id"`
+	OrgID               types.String                  `tfsdk:"org_id"`
+	Name                types.String                  `tfsdk:"name"`
+	Type                types.String                  `tfsdk:"type"`
+	Config              types.Map                     `tfsdk:"config"`
+	ConfigJSON          types.String                  `tfsdk:"config_json"`
+	ConfigHash          types.String                  `tfsdk:"config_hash"`
+	IntervalSeconds     types.Int64                   `tfsdk:"interval_seconds"`
+	Regions             types.Set                     `tfsdk:"regions"`
+	Enabled             types.Bool                    `tfsdk:"enabled"`
+	Inverted            types.Bool                    `tfsdk:"inverted"`
+	SimultaneousRegions types.Bool                    `tfsdk:"simultaneous_regions"`
+	RecheckOnFailure    types.Bool                    `tfsdk:"recheck_on_failure"`
+	ShowOnStatusPage    types.Bool                    `tfsdk:"show_on_status_page"`
+	ExpiresAfterSeconds types.Int64                   `tfsdk:"expires_after_seconds"`
+	DependsOn           types.Set                     `tfsdk:"check_dependencies"`
+	IaCLocked           types.Bool                    `tfsdk:"iac_locked"`
+	Tags                types.Set                     `tfsdk:"tags"`
+	PingURL             types.String                  `tfsdk:"ping_url"`
+	PausedUntil         types.String                  `tfsdk:"paused_until"`
+	Paused              types.Bool                    `tfsdk:"paused"`
+	RunOnChange         types.Map                     `tfsdk:"run_on_change"`
+	HealthStatus        types.String                  `tfsdk:"health_status"`
+	LastChecked         types.String                  `tfsdk:"last_checked"`
+	CreatedAt           types.String                  `tfsdk:"created_at"`
+	UpdatedAt           types.String                  `tfsdk:"updated_at"`
+	Schedule            *checkScheduleModel           `tfsdk:"schedule"`
+	WaitForHealthy      *checkWaitForHealthyModel     `tfsdk:"wait_for_healthy"`
+	MailDeliverability  *checkMailDeliverabilityModel `tfsdk:"mail_deliverability"`
 }
 
 // Metadata returns the resource type name.
@@ -85,19 +102,31 @@ func (r *checkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 			},
 			"type": schema.StringAttribute{
-				Description: "Check type: http, https, tcp, ping, udp, dns, dnssec, ssl, multistep, smtp-imap, throughput, http3, spf, dkim, or dmarc.",
+				Description: "Check type: http, https, tcp, ping, udp, dns, dnssec, ssl, multistep, smtp-imap, throughput, http3, spf, dkim, dmarc, heartbeat, browser, grpc, websocket, sse, postgres, mysql, redis, mongodb, domain_expiry, or dns_propagation.",
 				Required:    true,
 			},
 			"config": schema.MapAttribute{
-				Description: "Check-specific configuration (for simple types). Use config_json for complex nested configs like multistep. Password fields (smtp_password, imap_password, password) are sensitive and cannot be re-read from the API.",
+				Description: "Check-specific configuration (for simple types). Use config_json for complex nested configs like multistep. Password fields (smtp_password, imap_password, password) are sensitive and cannot be re-read from the API; prefer mail_deliverability for smtp-imap checks. " +
+					"Heartbeat checks take period_seconds or cron, and optionally grace_seconds and timezone (with cron). " +
+					"Domain expiry checks take domain and expiry_threshold_days. " +
+					"DNS propagation checks take domain, record_type, and optionally nameservers and expected_values (jsonencode()d lists) and quorum_percent.",
 				Optional:    true,
 				Sensitive:   true,
 				ElementType: types.StringType,
 			},
 			"config_json": schema.StringAttribute{
-				Description: "Check configuration as JSON string (required for multistep, smtp-imap, and other complex configs). Use jsonencode() to create this. Password fields are sensitive and cannot be re-read from the API.",
-				Optional:    true,
-				Sensitive:   true,
+				Description: "Check configuration as JSON string (required for multistep, smtp-imap, and other complex configs). Use jsonencode() to create this. Password fields are sensitive and cannot be re-read from the API. " +
+					"Browser checks take a Playwright script (e.g. from file()) or a list of steps, each with name, action, the fields the action needs and an optional timeout_seconds, " +
+					"and optionally timeout_seconds, screenshot_on_failure, viewport and web_vitals thresholds (lcp_ms, inp_ms, cls). " +
+					"gRPC checks take host and port, TLS options (tls, tls_server_name, ca_certificate, client_certificate, client_key), metadata and timeout_seconds; " +
+					"service for a grpc.health.v1 health check, or method with request, descriptor_set, expected_status and response_assertions for a unary call. " +
+					"WebSocket checks take a ws:// or wss:// url, optional headers, subprotocols and message, and expected_contains or expected_regex with reply_timeout_ms. " +
+					"SSE checks take url, optional headers and event, and expected_contains or expected_regex with timeout_ms. Both can also be used as multistep step types. " +
+					"Database checks (postgres, mysql, redis, mongodb) take host and port, username and password, database, TLS options and timeout_seconds; " +
+					"postgres and mysql run a read-only query with expected_rows, expected_value or min_value/max_value, redis runs a read-only command with the same value assertions, " +
+					"and mongodb takes a uri (without credentials) or host, auth_source and a command with response_assertions.",
+				Optional:  true,
+				Sensitive: true,
 			},
 			"config_hash": schema.StringAttribute{
 				Description: "Hash of sensitive config fields for drift detection. Use this to detect if passwords have changed externally.",
@@ -108,7 +137,7 @@ func (r *checkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 			},
 			"regions": schema.SetAttribute{
-				Description: "Monitoring regions (set - order does not matter, duplicates not allowed).",
+				Description: "Monitoring regions (set - order does not matter, duplicates not allowed). Public region codes from the quismon_regions data source, or quismon_private_location IDs.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
@@ -157,12 +186,41 @@ func (r *checkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					ExpiresAfterSecondsModifier(),
 				},
 			},
+			"tags": schema.SetAttribute{
+				Description: "Free-form tags, e.g. to select checks in quismon_export_sink filters.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"iac_locked": schema.BoolAttribute{
 				Description: "If true, this check can only be modified via API (prevents web UI changes).",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"paused_until": schema.StringAttribute{
+				Description: "Pause the check until this RFC 3339 timestamp, e.g. during planned maintenance. Unlike enabled = false, the check resumes by itself; setting a time that has passed resumes it right away. " +
+					"Pauses applied, extended or lifted outside Terraform are reported by paused and are not treated as drift.",
+				Optional: true,
+				Validators: []validator.String{
+					RFC3339Timestamp(),
+				},
+			},
+			"paused": schema.BoolAttribute{
+				Description: "Whether the check is currently paused, by paused_until or outside Terraform.",
+				Computed:    true,
+			},
+			"run_on_change": schema.MapAttribute{
+				Description: "Arbitrary values that trigger an immediate run of the check in all of its regions when they change, e.g. a deployed version. " +
+					"The per-region results are reported as a warning after apply; a failed run does not fail the apply.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"ping_url": schema.StringAttribute{
+				Description: "Heartbeat checks only: URL the monitored job must POST to (append /start or /fail to signal a start or failure). " +
+					"The check fails when no ping arrives in time. Null for other check types.",
+				Computed:  true,
+				Sensitive: true,
+			},
 			"health_status": schema.StringAttribute{
 				Description: "Current health status: healthy, unhealthy, or unknown.",
 				Computed:    true,
@@ -180,6 +238,81 @@ func (r *checkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"schedule": schema.SingleNestedBlock{
+				Description: "Restricts when the check runs at interval_seconds, e.g. to business hours. " +
+					"Outside the windows the check runs every off_hours_interval_seconds, or not at all if that is not set.",
+				Attributes: map[string]schema.Attribute{
+					"timezone": schema.StringAttribute{
+						Description: "IANA time zone the windows are in, e.g. Europe/Berlin or UTC.",
+						Required:    true,
+						Validators: []validator.String{
+							TimeZone(),
+						},
+					},
+					"off_hours_interval_seconds": schema.Int64Attribute{
+						Description: "Check interval in seconds outside the windows (minimum 60). If not set, the check does not run outside the windows.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(60),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"window": schema.ListNestedBlock{
+						Description: "Time window in which the check runs at interval_seconds. Windows must not overlap.",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"days": schema.SetAttribute{
+									Description: "Weekdays the window applies to: mon, tue, wed, thu, fri, sat, or sun.",
+									Required:    true,
+									ElementType: types.StringType,
+									Validators: []validator.Set{
+										setvalidator.SizeAtLeast(1),
+										setvalidator.ValueStringsAre(stringvalidator.OneOf(scheduleDays...)),
+									},
+								},
+								"start": schema.StringAttribute{
+									Description: "Start of the window as HH:MM, inclusive.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(scheduleStartPattern, "must be a time of day as HH:MM, e.g. 08:00"),
+									},
+								},
+								"end": schema.StringAttribute{
+									Description: "End of the window as HH:MM, exclusive. Use 24:00 for the end of the day.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(scheduleEndPattern, "must be a time of day as HH:MM, e.g. 18:00, or 24:00"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"wait_for_healthy": schema.SingleNestedBlock{
+				Description: "If set, create and update wait until the check reports healthy, and fail with the last error otherwise. " +
+					"Useful to run the check as a smoke test in a deploy pipeline. Ignored for disabled and paused checks.",
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.StringAttribute{
+						Description: "How long to wait, as a duration such as 90s or 10m. Default is 5m.",
+						Optional:    true,
+						Validators: []validator.String{
+							Duration(),
+						},
+					},
+					"min_regions": schema.Int64Attribute{
+						Description: "Number of regions that must report a successful run. Default is 1.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"mail_deliverability": checkMailDeliverabilityBlock(),
+		},
 	}
 }
 
@@ -201,6 +334,161 @@ func (r *checkResource) Configure(_ context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
+// ValidateConfig validates the check configuration of types with known config schemas.
+func (r *checkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config checkResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.WaitForHealthy != nil && !config.WaitForHealthy.MinRegions.IsNull() && !config.WaitForHealthy.MinRegions.IsUnknown() &&
+		!config.Regions.IsNull() && !config.Regions.IsUnknown() {
+		if minRegions := config.WaitForHealthy.MinRegions.ValueInt64(); minRegions > int64(len(config.Regions.Elements())) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_for_healthy").AtName("min_regions"),
+				"Invalid min_regions",
+				fmt.Sprintf("min_regions = %d exceeds the %d regions the check runs in.", minRegions, len(config.Regions.Elements())),
+			)
+		}
+	}
+
+	if config.Schedule != nil {
+		if len(config.Schedule.Windows) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schedule"),
+				"Missing Schedule Window",
+				"A schedule needs at least one window block.",
+			)
+		}
+		validateCheckSchedule(ctx, config.Schedule, &resp.Diagnostics)
+	}
+
+	if config.MailDeliverability != nil {
+		validateCheckMailDeliverability(config, &resp.Diagnostics)
+		return
+	}
+
+	if config.Type.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+	checkType := config.Type.ValueString()
+	if _, ok := checkConfigValidators[checkType]; !ok && checkType != "smtp-imap" {
+		return
+	}
+
+	cfg := checkConfig{}
+	attrPath := path.Root("config")
+	switch {
+	case config.ConfigJSON.IsUnknown() || config.Config.IsUnknown():
+		return
+	case !config.ConfigJSON.IsNull() && config.ConfigJSON.ValueString() != "":
+		attrPath = path.Root("config_json")
+		if err := json.Unmarshal([]byte(config.ConfigJSON.ValueString()), &cfg); err != nil {
+			resp.Diagnostics.AddAttributeError(attrPath, "Invalid config_json", "Could not parse config_json as a JSON object: "+err.Error())
+			return
+		}
+	case !config.Config.IsNull():
+		for key, value := range config.Config.Elements() {
+			strVal, ok := value.(types.String)
+			if !ok || strVal.IsUnknown() {
+				// Validated again once all values are known
+				return
+			}
+			cfg[key] = strVal.ValueString()
+		}
+	}
+
+	if checkType == "smtp-imap" {
+		// Existing free-form configurations must keep planning, so problems
+		// are only warnings here; mail_deliverability is validated strictly.
+		for _, problem := range validateSMTPIMAPConfig(cfg) {
+			resp.Diagnostics.AddAttributeWarning(
+				attrPath,
+				"Possibly Invalid Check Configuration",
+				fmt.Sprintf("The smtp-imap configuration may be rejected by the API: %s.", problem),
+			)
+		}
+		if cfg.has("smtp_password") || cfg.has("imap_password") {
+			resp.Diagnostics.AddAttributeWarning(
+				attrPath,
+				"Free-form Mail Passwords",
+				"smtp_password and imap_password in config or config_json are deprecated for smtp-imap checks. "+
+					"Move the configuration to the mail_deliverability block, which marks the passwords sensitive individually and is validated at plan time.",
+			)
+		}
+		return
+	}
+
+	for _, problem := range validateCheckConfig(checkType, cfg) {
+		resp.Diagnostics.AddAttributeError(
+			attrPath,
+			"Invalid Check Configuration",
+			fmt.Sprintf("Invalid configuration for %s check: %s.", checkType, problem),
+		)
+	}
+}
+
+// ModifyPlan validates the planned check against the organization's tier limits.
+func (r *checkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The ping URL only changes when the check type does, so keep it known across updates
+	if !req.State.Raw.IsNull() {
+		var planType, stateType, statePingURL types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &planType)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateType)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ping_url"), &statePingURL)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if planType.Equal(stateType) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ping_url"), statePingURL)...)
+		}
+	}
+
+	// Nothing more to check when limits could not be fetched
+	if r.client == nil || r.client.Organization == nil {
+		return
+	}
+
+	// Don't re-validate (and warn on) checks that are not changing
+	if !req.State.Raw.IsNull() && resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var plan checkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var checkType string
+	if !plan.Type.IsUnknown() {
+		checkType = plan.Type.ValueString()
+	}
+	var intervalSeconds int64
+	if !plan.IntervalSeconds.IsUnknown() {
+		intervalSeconds = plan.IntervalSeconds.ValueInt64()
+	}
+	var regionCount int
+	if !plan.Regions.IsUnknown() && !plan.Regions.IsNull() {
+		regionCount = len(plan.Regions.Elements())
+	}
+
+	resp.Diagnostics.Append(checkPlanLimits(
+		r.client.Organization,
+		checkType,
+		intervalSeconds,
+		regionCount,
+		plan.Enabled.ValueBool(),
+		req.State.Raw.IsNull(),
+	)...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *checkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan checkResourceModel
@@ -210,10 +498,13 @@ func (r *checkResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Build config from either config map or config_json
+	// Build config from mail_deliverability, the config map or config_json
 	var configMap map[string]interface{}
 
-	if !plan.ConfigJSON.IsNull() && plan.ConfigJSON.ValueString() != "" {
+	if plan.MailDeliverability != nil {
+		// Plan values are all known at apply time, so known is always true here
+		configMap, _ = expandCheckMailDeliverability(plan.MailDeliverability)
+	} else if !plan.ConfigJSON.IsNull() && plan.ConfigJSON.ValueString() != "" {
 		// Use config_json (for complex configs like multistep)
 		if err := json.Unmarshal([]byte(plan.ConfigJSON.ValueString()), &configMap); err != nil {
 			resp.Diagnostics.AddError(
@@ -233,7 +524,7 @@ func (r *checkResource) Create(ctx context.Context, req resource.CreateRequest,
 	} else {
 		resp.Diagnostics.AddError(
 			"Missing Configuration",
-			"Either 'config' or 'config_json' must be specified",
+			"One of 'config', 'config_json' or 'mail_deliverability' must be specified",
 		)
 		return
 	}
@@ -256,6 +547,18 @@ func (r *checkResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
+	tags := []string{}
+	if !plan.Tags.IsNull() {
+		diags = plan.Tags.ElementsAs(ctx, &tags, false)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	schedule, diags := expandCheckSchedule(ctx, plan.Schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the check
 	createReq := client.CreateCheckRequest{
 		Name:                plan.Name.ValueString(),
@@ -269,6 +572,8 @@ func (r *checkResource) Create(ctx context.Context, req resource.CreateRequest,
 		RecheckOnFailure:    plan.RecheckOnFailure.ValueBoolPointer(),
 		ShowOnStatusPage:    plan.ShowOnStatusPage.ValueBoolPointer(),
 		DependsOn:           dependsOn,
+		Tags:                tags,
+		Schedule:            schedule,
 	}
 
 	// Only set expires_after_seconds if it's explicitly set (non-zero)
@@ -277,6 +582,7 @@ func (r *checkResource) Create(ctx context.Context, req resource.CreateRequest,
 		createReq.ExpiresAfterSeconds = &expiresAfter
 	}
 
+	startedAt := time.Now()
 	check, err := r.client.CreateCheck(createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -285,6 +591,9 @@ func (r *checkResource) Create(ctx context.Context, req resource.CreateRequest,
 		)
 		return
 	}
+	if paused := applyCheckPause(r.client, check.ID, &plan.PausedUntil, types.StringNull(), &resp.Diagnostics); paused != nil {
+		check = paused
+	}
 
 	// Map response to state
 	plan.ID = types.StringValue(check.ID)
@@ -305,6 +614,8 @@ func (r *checkResource) Create(ctx context.Context, req resource.CreateRequest,
 	} else {
 		plan.ExpiresAfterSeconds = types.Int64Null()
 	}
+	plan.PingURL = optionalString(check.PingURL)
+	plan.Paused = types.BoolValue(check.IsPaused(time.Now()))
 	plan.HealthStatus = types.StringValue(check.HealthStatus)
 	if check.LastChecked != nil {
 		plan.LastChecked = types.StringValue(*check.LastChecked)
@@ -316,6 +627,15 @@ func (r *checkResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if runOnChangeTriggered(plan.RunOnChange, types.MapNull(types.StringType)) {
+		runCheckOnChange(ctx, r.client, check, &resp.Diagnostics)
+	}
+
+	r.waitForHealthy(ctx, &plan, startedAt, &resp.State, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -374,6 +694,20 @@ func (r *checkResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	} else {
 		state.ExpiresAfterSeconds = types.Int64Null()
 	}
+	if len(check.Tags) > 0 {
+		tagSet, _ := types.SetValueFrom(ctx, types.StringType, check.Tags)
+		state.Tags = tagSet
+	} else if len(state.Tags.Elements()) > 0 {
+		// An empty set (tags = []) is kept as is; the API returns no tags for both
+		state.Tags = types.SetNull(types.StringType)
+	}
+	state.PingURL = optionalString(check.PingURL)
+	// paused_until is not refreshed: a temporary pause is not drift. Pauses applied,
+	// extended or lifted outside Terraform (e.g. during incident response) are only
+	// reflected in paused, and the configured value is kept.
+	state.Schedule, diags = flattenCheckSchedule(ctx, check.Schedule)
+	resp.Diagnostics.Append(diags...)
+	state.Paused = types.BoolValue(check.IsPaused(time.Now()))
 	state.HealthStatus = types.StringValue(check.HealthStatus)
 	if check.LastChecked != nil {
 		state.LastChecked = types.StringValue(*check.LastChecked)
@@ -401,10 +735,13 @@ func (r *checkResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Build config from either config map or config_json
+	// Build config from mail_deliverability, the config map or config_json
 	var configMap map[string]interface{}
 
-	if !plan.ConfigJSON.IsNull() && plan.ConfigJSON.ValueString() != "" {
+	if plan.MailDeliverability != nil {
+		// Plan values are all known at apply time, so known is always true here
+		configMap, _ = expandCheckMailDeliverability(plan.MailDeliverability)
+	} else if !plan.ConfigJSON.IsNull() && plan.ConfigJSON.ValueString() != "" {
 		// Use config_json (for complex configs like multistep)
 		if err := json.Unmarshal([]byte(plan.ConfigJSON.ValueString()), &configMap); err != nil {
 			resp.Diagnostics.AddError(
@@ -441,6 +778,18 @@ func (r *checkResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	tags := []string{}
+	if !plan.Tags.IsNull() {
+		diags = plan.Tags.ElementsAs(ctx, &tags, false)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	schedule, diags := expandCheckSchedule(ctx, plan.Schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update the check
 	name := plan.Name.ValueString()
 	checkType := plan.Type.ValueString()
@@ -463,11 +812,14 @@ func (r *checkResource) Update(ctx context.Context, req resource.UpdateRequest,
 		RecheckOnFailure:    &recheckOnFailure,
 		ShowOnStatusPage:    &showOnStatusPage,
 		DependsOn:           &dependsOn,
+		Tags:                &tags,
+		Schedule:            schedule,
 		// Note: We deliberately do NOT set ExpiresAfterSeconds here.
 		// Expiring checks are typically temporary and managed via API,
 		// not Terraform. We don't want to tamper with them.
 	}
 
+	startedAt := time.Now()
 	check, err := r.client.UpdateCheck(plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -476,6 +828,11 @@ func (r *checkResource) Update(ctx context.Context, req resource.UpdateRequest,
 		)
 		return
 	}
+	var priorPausedUntil types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("paused_until"), &priorPausedUntil)...)
+	if paused := applyCheckPause(r.client, plan.ID.ValueString(), &plan.PausedUntil, priorPausedUntil, &resp.Diagnostics); paused != nil {
+		check = paused
+	}
 
 	// Map response to state
 	plan.OrgID = types.StringValue(check.OrgID)
@@ -501,6 +858,8 @@ func (r *checkResource) Update(ctx context.Context, req resource.UpdateRequest,
 	} else {
 		plan.ExpiresAfterSeconds = types.Int64Null()
 	}
+	plan.PingURL = optionalString(check.PingURL)
+	plan.Paused = types.BoolValue(check.IsPaused(time.Now()))
 	plan.HealthStatus = types.StringValue(check.HealthStatus)
 	if check.LastChecked != nil {
 		plan.LastChecked = types.StringValue(*check.LastChecked)
@@ -512,6 +871,41 @@ func (r *checkResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorRunOnChange types.Map
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("run_on_change"), &priorRunOnChange)...)
+	if runOnChangeTriggered(plan.RunOnChange, priorRunOnChange) {
+		runCheckOnChange(ctx, r.client, check, &resp.Diagnostics)
+	}
+
+	r.waitForHealthy(ctx, &plan, startedAt, &resp.State, &resp.Diagnostics)
+}
+
+// waitForHealthy blocks until the check is healthy if wait_for_healthy is configured,
+// then records the latest health status in state.
+func (r *checkResource) waitForHealthy(ctx context.Context, plan *checkResourceModel, since time.Time, state *tfsdk.State, diags *diag.Diagnostics) {
+	if plan.WaitForHealthy == nil || !plan.Enabled.ValueBool() || plan.Paused.ValueBool() {
+		return
+	}
+
+	timeout, minRegions := plan.WaitForHealthy.settings()
+	check, err := waitForCheckHealthy(ctx, r.client, plan.ID.ValueString(), since, timeout, minRegions)
+	if err != nil {
+		diags.AddError(
+			"Check Did Not Become Healthy",
+			"The check was saved but did not pass wait_for_healthy: "+err.Error(),
+		)
+		return
+	}
+
+	plan.HealthStatus = types.StringValue(check.HealthStatus)
+	if check.LastChecked != nil {
+		plan.LastChecked = types.StringValue(*check.LastChecked)
+	}
+	diags.Append(state.Set(ctx, plan)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -2,9 +2,15 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccCheckResource(t *testing.T) {
@@ -205,6 +211,47 @@ func TestAccCheckResource_SMTPIMAP(t *testing.T) {
 	})
 }
 
+// TestAccCheckResource_MailDeliverability tests the typed smtp-imap configuration
+// Verifies that the passwords are still covered by config_hash
+func TestAccCheckResource_MailDeliverability(t *testing.T) {
+	// Changing only the smtp password must change config_hash
+	configHash := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig_mailDeliverability("test-mail-deliverability", "smtp-imap", "test-password-123"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.test", "type", "smtp-imap"),
+					resource.TestCheckResourceAttr("quismon_check.test", "mail_deliverability.require_auth_pass.#", "3"),
+					resource.TestCheckResourceAttrSet("quismon_check.test", "config_hash"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					configHash.AddStateValue("quismon_check.test", tfjsonpath.New("config_hash")),
+				},
+			},
+			{
+				ResourceName:            "quismon_check.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mail_deliverability"},
+			},
+			{
+				Config: testAccCheckResourceConfig_mailDeliverability("test-mail-deliverability", "smtp-imap", "rotated-password-789"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					configHash.AddStateValue("quismon_check.test", tfjsonpath.New("config_hash")),
+				},
+			},
+			{
+				Config:      testAccCheckResourceConfig_mailDeliverability("test-mail-deliverability", "https", "rotated-password-789"),
+				ExpectError: regexp.MustCompile(`can only be set on smtp-imap checks`),
+			},
+		},
+	})
+}
+
 // TestAccCheckResource_ConfigJSON tests using config_json for complex configs
 func TestAccCheckResource_ConfigJSON(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -279,6 +326,42 @@ resource "quismon_check" "test" {
 `, name)
 }
 
+func testAccCheckResourceConfig_mailDeliverability(name, checkType, smtpPassword string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "test" {
+  name             = %[1]q
+  type             = %[2]q
+  interval_seconds = 300
+
+  regions = ["us-east-1"]
+
+  mail_deliverability {
+    from_address      = "test@example.com"
+    to_address        = "test+inbox@example.com"
+    subject           = "Deliverability {{message_id}}"
+    max_wait_seconds  = 120
+    require_auth_pass = ["spf", "dkim", "dmarc"]
+
+    smtp {
+      host     = "smtp.example.com"
+      port     = 587
+      username = "test@example.com"
+      password = %[3]q
+      use_tls  = true
+    }
+
+    imap {
+      host     = "imap.example.com"
+      port     = 993
+      username = "test@example.com"
+      password = "test-password-456"
+      use_tls  = true
+    }
+  }
+}
+`, name, checkType, smtpPassword)
+}
+
 func testAccCheckResourceConfig_configJSON(name string) string {
 	return fmt.Sprintf(`
 resource "quismon_check" "test" {
@@ -433,3 +516,495 @@ resource "quismon_check" "test" {
 }
 `, name)
 }
+
+// TestAccCheckResource_Heartbeat tests a push-based heartbeat check and its ping URL
+func TestAccCheckResource_Heartbeat(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig_heartbeat("test-heartbeat", "3600"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.test", "type", "heartbeat"),
+					resource.TestCheckResourceAttr("quismon_check.test", "config.period_seconds", "3600"),
+					resource.TestCheckResourceAttrSet("quismon_check.test", "ping_url"),
+				),
+			},
+			// The ping URL is stable across updates
+			{
+				Config: testAccCheckResourceConfig_heartbeat("test-heartbeat", "7200"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("quismon_check.test", tfjsonpath.New("ping_url"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("quismon_check.test", "config.period_seconds", "7200"),
+			},
+			{
+				Config:      testAccCheckResourceConfig_heartbeat("test-heartbeat", "30"),
+				ExpectError: regexp.MustCompile(`period_seconds must be at least 60`),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceConfig_heartbeat(name, period string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "test" {
+  name             = %[1]q
+  type             = "heartbeat"
+  interval_seconds = 60
+
+  config = {
+    period_seconds = %[2]q
+    grace_seconds  = "300"
+  }
+}
+`, name, period)
+}
+
+func TestAccCheckResource_WaitForHealthy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig_waitForHealthy("test-wait", "https://example.com", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.test", "health_status", "healthy"),
+					resource.TestCheckResourceAttr("quismon_check.test", "wait_for_healthy.timeout", "3m"),
+					resource.TestCheckResourceAttrSet("quismon_check.test", "last_checked"),
+				),
+			},
+			{
+				Config:      testAccCheckResourceConfig_waitForHealthy("test-wait", "https://example.com", 2),
+				ExpectError: regexp.MustCompile(`exceeds the 1 regions`),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceConfig_waitForHealthy(name, url string, minRegions int) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "test" {
+  name             = %[1]q
+  type             = "https"
+  interval_seconds = 60
+
+  regions = ["us-east-1"]
+
+  config = {
+    url                  = %[2]q
+    expected_status_code = "200"
+  }
+
+  wait_for_healthy {
+    timeout     = "3m"
+    min_regions = %[3]d
+  }
+}
+`, name, url, minRegions)
+}
+
+func TestAccCheckResource_RunOnChange(t *testing.T) {
+	// The newest result must differ after each apply, i.e. the check really ran
+	newestResult := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig_runOnChange("test-run", "1.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.test", "run_on_change.version", "1.0.0"),
+					resource.TestCheckResourceAttrSet("data.quismon_check_results.test", "results.0.checked_at"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					newestResult.AddStateValue("data.quismon_check_results.test", tfjsonpath.New("results").AtSliceIndex(0).AtMapKey("checked_at")),
+				},
+			},
+			{
+				Config: testAccCheckResourceConfig_runOnChange("test-run", "1.0.1"),
+				Check:  resource.TestCheckResourceAttr("quismon_check.test", "run_on_change.version", "1.0.1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					newestResult.AddStateValue("data.quismon_check_results.test", tfjsonpath.New("results").AtSliceIndex(0).AtMapKey("checked_at")),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckResourceConfig_runOnChange(name, version string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "test" {
+  name             = %[1]q
+  type             = "https"
+  interval_seconds = 300
+
+  regions = ["us-east-1"]
+
+  config = {
+    url                  = "https://example.com"
+    expected_status_code = "200"
+  }
+
+  run_on_change = {
+    version = %[2]q
+  }
+}
+
+# depends_on defers the read until the check has been applied (and run)
+data "quismon_check_results" "test" {
+  check_id         = quismon_check.test.id
+  limit_per_region = 1
+
+  depends_on = [quismon_check.test]
+}
+`, name, version)
+}
+
+func TestAccCheckResource_PausedUntil(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig_pausedUntil("test-paused", `"2099-01-01T00:00:00Z"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("quismon_check.test", "paused", "true"),
+					resource.TestCheckResourceAttr("quismon_check.test", "paused_until", "2099-01-01T00:00:00Z"),
+				),
+			},
+			// Removing paused_until resumes the check
+			{
+				Config: testAccCheckResourceConfig_pausedUntil("test-paused", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.test", "paused", "false"),
+					resource.TestCheckNoResourceAttr("quismon_check.test", "paused_until"),
+				),
+			},
+			{
+				Config:      testAccCheckResourceConfig_pausedUntil("test-paused", `"next tuesday"`),
+				ExpectError: regexp.MustCompile(`Invalid Timestamp`),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceConfig_pausedUntil(name, pausedUntil string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "test" {
+  name             = %[1]q
+  type             = "https"
+  interval_seconds = 300
+  paused_until     = %[2]s
+
+  config = {
+    url                  = "https://example.com"
+    expected_status_code = "200"
+  }
+}
+`, name, pausedUntil)
+}
+
+func TestAccCheckResource_Schedule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig_schedule("test-schedule", "08:00", "18:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.test", "schedule.timezone", "Europe/Berlin"),
+					resource.TestCheckResourceAttr("quismon_check.test", "schedule.off_hours_interval_seconds", "3600"),
+					resource.TestCheckResourceAttr("quismon_check.test", "schedule.window.#", "1"),
+					resource.TestCheckResourceAttr("quismon_check.test", "schedule.window.0.days.#", "5"),
+					resource.TestCheckResourceAttr("quismon_check.test", "schedule.window.0.start", "08:00"),
+				),
+			},
+			{
+				Config:      testAccCheckResourceConfig_schedule("test-schedule", "18:00", "08:00"),
+				ExpectError: regexp.MustCompile(`Invalid Schedule Window`),
+			},
+			{
+				Config:      testAccCheckResourceConfig_schedule("test-schedule", "8am", "18:00"),
+				ExpectError: regexp.MustCompile(`must be a time of day`),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceConfig_schedule(name, start, end string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "test" {
+  name             = %[1]q
+  type             = "https"
+  interval_seconds = 60
+
+  config = {
+    url                  = "https://example.com"
+    expected_status_code = "200"
+  }
+
+  schedule {
+    timezone                   = "Europe/Berlin"
+    off_hours_interval_seconds = 3600
+
+    window {
+      days  = ["mon", "tue", "wed", "thu", "fri"]
+      start = %[2]q
+      end   = %[3]q
+    }
+  }
+}
+`, name, start, end)
+}
+
+func TestAccCheckResource_Browser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig_browser("test-browser", "navigate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.test", "type", "browser"),
+					resource.TestCheckResourceAttrSet("quismon_check.test", "config_json"),
+				),
+			},
+			{
+				Config:      testAccCheckResourceConfig_browser("test-browser", "click"),
+				ExpectError: regexp.MustCompile(`first step must be a navigate action`),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceConfig_browser(name, firstAction string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "test" {
+  name             = %[1]q
+  type             = "browser"
+  interval_seconds = 300
+
+  config_json = jsonencode({
+    steps = [
+      { name = "Open", action = %[2]q, url = "https://example.com", timeout_seconds = 15 },
+      { name = "Heading", action = "assert_text", selector = "h1", text = "Example Domain" }
+    ]
+    timeout_seconds       = 60
+    screenshot_on_failure = true
+  })
+}
+`, name, firstAction)
+}
+
+func TestAccCheckResource_GRPC(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig_grpc("test-grpc", "grpc.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.test", "type", "grpc"),
+					resource.TestCheckResourceAttr("quismon_check.test", "config.service", "grpc.health.v1.Health"),
+				),
+			},
+			{
+				Config:      testAccCheckResourceConfig_grpc("test-grpc", ""),
+				ExpectError: regexp.MustCompile(`host is required`),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceConfig_grpc(name, host string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "test" {
+  name             = %[1]q
+  type             = "grpc"
+  interval_seconds = 60
+
+  config = {
+    host    = %[2]q
+    port    = "443"
+    tls     = "true"
+    service = "grpc.health.v1.Health"
+  }
+}
+`, name, host)
+}
+
+func TestAccCheckResource_WebSocketAndSSE(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig_streaming("wss://echo.websocket.org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.websocket", "type", "websocket"),
+					resource.TestCheckResourceAttr("quismon_check.sse", "type", "sse"),
+					resource.TestCheckResourceAttr("quismon_check.multistep", "type", "multistep"),
+				),
+			},
+			{
+				Config:      testAccCheckResourceConfig_streaming("https://echo.websocket.org"),
+				ExpectError: regexp.MustCompile(`ws:// or wss:// URL`),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceConfig_streaming(websocketURL string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "websocket" {
+  name             = "test-websocket"
+  type             = "websocket"
+  interval_seconds = 60
+
+  config = {
+    url               = %[1]q
+    message           = "ping"
+    expected_contains = "ping"
+    reply_timeout_ms  = "5000"
+  }
+}
+
+resource "quismon_check" "sse" {
+  name             = "test-sse"
+  type             = "sse"
+  interval_seconds = 60
+
+  config = {
+    url        = "https://sse.dev/test"
+    timeout_ms = "30000"
+  }
+}
+
+resource "quismon_check" "multistep" {
+  name             = "test-multistep-websocket"
+  type             = "multistep"
+  interval_seconds = 300
+
+  config_json = jsonencode({
+    steps = [
+      {
+        name            = "Echo"
+        type            = "websocket"
+        timeout_seconds = 10
+        config = {
+          url               = %[1]q
+          message           = "hello"
+          expected_contains = "hello"
+        }
+      }
+    ]
+  })
+}
+`, websocketURL)
+}
+
+func TestAccCheckResource_Database(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig_database("SELECT 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.postgres", "type", "postgres"),
+					resource.TestCheckResourceAttrSet("quismon_check.postgres", "config_hash"),
+					resource.TestCheckResourceAttr("quismon_check.redis", "type", "redis"),
+				),
+			},
+			{
+				Config:      testAccCheckResourceConfig_database("DELETE FROM sessions"),
+				ExpectError: regexp.MustCompile(`read-only statement`),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceConfig_database(query string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "postgres" {
+  name             = "test-postgres"
+  type             = "postgres"
+  interval_seconds = 60
+
+  config = {
+    host      = "db.example.com"
+    database  = "app"
+    username  = "monitor"
+    password  = "test-password"
+    query     = %[1]q
+    max_value = "1"
+  }
+}
+
+resource "quismon_check" "redis" {
+  name             = "test-redis"
+  type             = "redis"
+  interval_seconds = 60
+
+  config = {
+    host     = "cache.example.com"
+    password = "test-password"
+    command  = "PING"
+  }
+}
+`, query)
+}
+
+func TestAccCheckResource_DomainExpiryAndDNSPropagation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig_domain("example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_check.domain_expiry", "type", "domain_expiry"),
+					resource.TestCheckResourceAttr("quismon_check.dns_propagation", "type", "dns_propagation"),
+				),
+			},
+			{
+				Config:      testAccCheckResourceConfig_domain("https://example.com"),
+				ExpectError: regexp.MustCompile(`fully qualified domain name`),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceConfig_domain(domain string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "domain_expiry" {
+  name             = "test-domain-expiry"
+  type             = "domain_expiry"
+  interval_seconds = 86400
+
+  config = {
+    domain                = %[1]q
+    expiry_threshold_days = "30"
+  }
+}
+
+resource "quismon_check" "dns_propagation" {
+  name             = "test-dns-propagation"
+  type             = "dns_propagation"
+  interval_seconds = 600
+
+  config = {
+    domain         = %[1]q
+    record_type    = "A"
+    nameservers    = jsonencode(["8.8.8.8", "1.1.1.1", "9.9.9.9"])
+    quorum_percent = "66"
+  }
+}
+`, domain)
+}
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+const (
+	defaultCheckResultsWindowHours = 24
+	defaultCheckResultsLimit       = 10
+)
+
+var _ datasource.DataSource = &checkResultsDataSource{}
+
+func NewCheckResultsDataSource() datasource.DataSource {
+	return &checkResultsDataSource{}
+}
+
+type checkResultsDataSource struct {
+	client *client.Client
+}
+
+// checkResultsDataSourceModel maps the data source schema data
+type checkResultsDataSourceModel struct {
+	CheckID        types.String             `tfsdk:"check_id"`
+	WindowHours    types.Int64              `tfsdk:"window_hours"`
+	LimitPerRegion types.Int64              `tfsdk:"limit_per_region"`
+	Regions        types.Set                `tfsdk:"regions"`
+	UptimePercent  types.Float64            `tfsdk:"uptime_percent"`
+	TotalRuns      types.Int64              `tfsdk:"total_runs"`
+	FailedRuns     types.Int64              `tfsdk:"failed_runs"`
+	LatencyP50Ms   types.Float64            `tfsdk:"latency_p50_ms"`
+	LatencyP95Ms   types.Float64            `tfsdk:"latency_p95_ms"`
+	LatencyP99Ms   types.Float64            `tfsdk:"latency_p99_ms"`
+	RegionUptime   []checkRegionUptimeModel `tfsdk:"region_uptime"`
+	Results        []checkResultModel       `tfsdk:"results"`
+}
+
+// checkRegionUptimeModel maps the uptime of a single region
+type checkRegionUptimeModel struct {
+	Region        types.String  `tfsdk:"region"`
+	UptimePercent types.Float64 `tfsdk:"uptime_percent"`
+	TotalRuns     types.Int64   `tfsdk:"total_runs"`
+	FailedRuns    types.Int64   `tfsdk:"failed_runs"`
+	LatencyP50Ms  types.Float64 `tfsdk:"latency_p50_ms"`
+	LatencyP95Ms  types.Float64 `tfsdk:"latency_p95_ms"`
+	LatencyP99Ms  types.Float64 `tfsdk:"latency_p99_ms"`
+}
+
+// checkResultModel maps a single check run
+type checkResultModel struct {
+	Region                types.String  `tfsdk:"region"`
+	Status                types.String  `tfsdk:"status"`
+	LatencyMs             types.Float64 `tfsdk:"latency_ms"`
+	Error                 types.String  `tfsdk:"error"`
+	FailingStep           types.String  `tfsdk:"failing_step"`
+	ScreenshotURL         types.String  `tfsdk:"screenshot_url"`
+	DeliveryLatencyMs     types.Float64 `tfsdk:"delivery_latency_ms"`
+	AuthenticationResults types.Map     `tfsdk:"authentication_results"`
+	CheckedAt             types.String  `tfsdk:"checked_at"`
+}
+
+func (d *checkResultsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_results"
+}
+
+func (d *checkResultsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	uptimeAttributes := func() map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"uptime_percent": schema.Float64Attribute{
+				Description: "Percentage of successful runs in the window.",
+				Computed:    true,
+			},
+			"total_runs": schema.Int64Attribute{
+				Description: "Number of runs in the window.",
+				Computed:    true,
+			},
+			"failed_runs": schema.Int64Attribute{
+				Description: "Number of failed runs in the window.",
+				Computed:    true,
+			},
+			"latency_p50_ms": schema.Float64Attribute{
+				Description: "Median latency in milliseconds.",
+				Computed:    true,
+			},
+			"latency_p95_ms": schema.Float64Attribute{
+				Description: "95th percentile latency in milliseconds.",
+				Computed:    true,
+			},
+			"latency_p99_ms": schema.Float64Attribute{
+				Description: "99th percentile latency in milliseconds.",
+				Computed:    true,
+			},
+		}
+	}
+
+	attributes := uptimeAttributes()
+	attributes["check_id"] = schema.StringAttribute{
+		Description: "ID of the check.",
+		Required:    true,
+	}
+	attributes["window_hours"] = schema.Int64Attribute{
+		Description: "Window in hours over which uptime is aggregated and results are returned (1-2160). Default is 24.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 2160),
+		},
+	}
+	attributes["limit_per_region"] = schema.Int64Attribute{
+		Description: "Maximum number of recent results returned per region (1-100). Default is 10.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 100),
+		},
+	}
+	attributes["regions"] = schema.SetAttribute{
+		Description: "Only return results from these regions. Uptime is always aggregated over all regions.",
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+
+	regionAttributes := uptimeAttributes()
+	regionAttributes["region"] = schema.StringAttribute{
+		Computed: true,
+	}
+	attributes["region_uptime"] = schema.ListNestedAttribute{
+		Description: "Uptime and latency per region.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: regionAttributes,
+		},
+	}
+	attributes["results"] = schema.ListNestedAttribute{
+		Description: "Recent results, newest first.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"region": schema.StringAttribute{
+					Computed: true,
+				},
+				"status": schema.StringAttribute{
+					Description: "success, failure, timeout, or dependency_failed.",
+					Computed:    true,
+				},
+				"latency_ms": schema.Float64Attribute{
+					Computed: true,
+				},
+				"error": schema.StringAttribute{
+					Description: "Error message of a failed run.",
+					Computed:    true,
+				},
+				"failing_step": schema.StringAttribute{
+					Description: "Multistep and browser checks only: name of the step that failed.",
+					Computed:    true,
+				},
+				"screenshot_url": schema.StringAttribute{
+					Description: "Browser checks only: URL of the screenshot taken when the run failed (see screenshot_on_failure).",
+					Computed:    true,
+				},
+				"delivery_latency_ms": schema.Float64Attribute{
+					Description: "smtp-imap checks only: time from SMTP submission until the message arrived in the IMAP mailbox.",
+					Computed:    true,
+				},
+				"authentication_results": schema.MapAttribute{
+					Description: "smtp-imap checks only: spf, dkim and dmarc results (e.g. pass, fail, none) from the Authentication-Results header of the received message.",
+					Computed:    true,
+					ElementType: types.StringType,
+				},
+				"checked_at": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches recent results and aggregated uptime of a Quismon check, e.g. for post-deploy verification.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *checkResultsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *checkResultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data checkResultsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WindowHours.IsNull() {
+		data.WindowHours = types.Int64Value(defaultCheckResultsWindowHours)
+	}
+	if data.LimitPerRegion.IsNull() {
+		data.LimitPerRegion = types.Int64Value(defaultCheckResultsLimit)
+	}
+	var regions []string
+	if !data.Regions.IsNull() {
+		diags = data.Regions.ElementsAs(ctx, &regions, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	checkID := data.CheckID.ValueString()
+	windowHours := data.WindowHours.ValueInt64()
+
+	uptime, err := d.client.GetCheckUptime(checkID, int(windowHours))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Check Uptime", err.Error())
+		return
+	}
+
+	results, err := d.client.GetCheckResults(checkID, client.CheckResultsOptions{
+		Regions:        regions,
+		LimitPerRegion: int(data.LimitPerRegion.ValueInt64()),
+		Since:          time.Now().UTC().Add(-time.Duration(windowHours) * time.Hour).Format(time.RFC3339),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Check Results", err.Error())
+		return
+	}
+
+	data.UptimePercent = types.Float64Value(uptime.UptimePercent)
+	data.TotalRuns = types.Int64Value(int64(uptime.TotalRuns))
+	data.FailedRuns = types.Int64Value(int64(uptime.FailedRuns))
+	data.LatencyP50Ms = types.Float64Value(uptime.LatencyP50Ms)
+	data.LatencyP95Ms = types.Float64Value(uptime.LatencyP95Ms)
+	data.LatencyP99Ms = types.Float64Value(uptime.LatencyP99Ms)
+
+	data.RegionUptime = []checkRegionUptimeModel{}
+	for _, region := range uptime.Regions {
+		data.RegionUptime = append(data.RegionUptime, checkRegionUptimeModel{
+			Region:        types.StringValue(region.Region),
+			UptimePercent: types.Float64Value(region.UptimePercent),
+			TotalRuns:     types.Int64Value(int64(region.TotalRuns)),
+			FailedRuns:    types.Int64Value(int64(region.FailedRuns)),
+			LatencyP50Ms:  types.Float64Value(region.LatencyP50Ms),
+			LatencyP95Ms:  types.Float64Value(region.LatencyP95Ms),
+			LatencyP99Ms:  types.Float64Value(region.LatencyP99Ms),
+		})
+	}
+
+	data.Results = []checkResultModel{}
+	for _, result := range results {
+		authResults := types.MapNull(types.StringType)
+		if len(result.AuthenticationResults) > 0 {
+			authResults, diags = types.MapValueFrom(ctx, types.StringType, result.AuthenticationResults)
+			resp.Diagnostics.Append(diags...)
+		}
+		data.Results = append(data.Results, checkResultModel{
+			Region:                types.StringValue(result.Region),
+			Status:                types.StringValue(result.Status),
+			LatencyMs:             types.Float64Value(result.LatencyMs),
+			Error:                 optionalString(result.Error),
+			FailingStep:           optionalString(result.FailingStep),
+			ScreenshotURL:         optionalString(result.ScreenshotURL),
+			DeliveryLatencyMs:     types.Float64PointerValue(result.DeliveryLatencyMs),
+			AuthenticationResults: authResults,
+			CheckedAt:             types.StringValue(result.CheckedAt),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+const (
+	// checkRunDefaultTimeout is how long a check is assumed to take in one region
+	// when its config sets no timeout of its own.
+	checkRunDefaultTimeout = 30 * time.Second
+	// checkRunMargin covers scheduling the run and collecting the results.
+	checkRunMargin = time.Minute
+)
+
+// checkRunTimeoutKeys are the config keys bounding how long a single run of a
+// check takes, with the duration of one unit of each.
+var checkRunTimeoutKeys = []struct {
+	key  string
+	unit time.Duration
+}{
+	{"timeout_seconds", time.Second},
+	{"max_wait_seconds", time.Second},
+	{"timeout_ms", time.Millisecond},
+	{"reply_timeout_ms", time.Millisecond},
+}
+
+// checkRunTimeout bounds an on-demand run triggered by run_on_change: the check's
+// own timeout in each region, times the number of regions when they are
+// staggered rather than run simultaneously, plus a margin.
+func checkRunTimeout(check *client.Check) time.Duration {
+	perRegion := time.Duration(0)
+	config := checkConfig(check.Config)
+	for _, t := range checkRunTimeoutKeys {
+		if v, ok, err := config.float(t.key); ok && err == nil && v > 0 {
+			perRegion += time.Duration(v * float64(t.unit))
+		}
+	}
+	if perRegion == 0 {
+		perRegion = checkRunDefaultTimeout
+	}
+
+	regions := 1
+	if !check.SimultaneousRegions && len(check.Regions) > 1 {
+		regions = len(check.Regions)
+	}
+	return perRegion*time.Duration(regions) + checkRunMargin
+}
+
+// runCheckOnChange runs the check in all of its regions and reports the per-region
+// results as a warning. A failed run does not fail the apply: the check itself was
+// saved, and wait_for_healthy is the way to gate an apply on the check passing.
+func runCheckOnChange(ctx context.Context, c *client.Client, check *client.Check, diags *diag.Diagnostics) {
+	ctx, cancel := context.WithTimeout(ctx, checkRunTimeout(check))
+	defer cancel()
+
+	checkID := check.ID
+	results, err := c.RunCheck(ctx, checkID, nil)
+	if err != nil {
+		diags.AddWarning(
+			"Error Running Check",
+			"run_on_change changed but check ID "+checkID+" could not be run: "+err.Error(),
+		)
+		return
+	}
+
+	summary, failed := summarizeCheckRun(results)
+	title := "Check Run Passed"
+	if failed > 0 {
+		title = fmt.Sprintf("Check Run Failed in %d of %d Regions", failed, len(results))
+	}
+	diags.AddWarning(title, "run_on_change triggered a run of check ID "+checkID+":\n\n"+summary)
+}
+
+// summarizeCheckRun formats one line per region, sorted by region, and counts
+// the regions that did not succeed.
+func summarizeCheckRun(results []client.CheckResult) (summary string, failed int) {
+	if len(results) == 0 {
+		return "no regions reported a result", 0
+	}
+
+	sorted := append([]client.CheckResult(nil), results...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Region < sorted[j].Region })
+
+	lines := make([]string, 0, len(sorted))
+	for _, result := range sorted {
+		line := fmt.Sprintf("%s: %s in %.0fms", result.Region, result.Status, result.LatencyMs)
+		if result.Status != "success" {
+			failed++
+			if result.FailingStep != "" {
+				line += " at step " + result.FailingStep
+			}
+			if result.Error != "" {
+				line += " (" + result.Error + ")"
+			}
+			if result.ScreenshotURL != "" {
+				line += ", screenshot: " + result.ScreenshotURL
+			}
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n"), failed
+}
+
+// runOnChangeTriggered reports whether run_on_change is set and differs from its
+// prior value. On create the prior value is null.
+func runOnChangeTriggered(planned, prior types.Map) bool {
+	if planned.IsNull() || planned.IsUnknown() {
+		return false
+	}
+	return !planned.Equal(prior)
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+func TestRunCheckOnChange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/checks/chk-1/run" {
+			http.NotFound(w, r)
+			return
+		}
+		var body client.RunCheckRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decoding request body: %s", err)
+		}
+		if len(body.Regions) != 0 {
+			t.Errorf("expected the check to run in all regions, got %v", body.Regions)
+		}
+		fmt.Fprint(w, `{"data":[`+
+			`{"region":"us-east-1","status":"success","latency_ms":120},`+
+			`{"region":"eu-west-1","status":"failure","latency_ms":3000,"failing_step":"login","error":"status 500"}]}`)
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var diags diag.Diagnostics
+	runCheckOnChange(context.Background(), c, &client.Check{ID: "chk-1"}, &diags)
+
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got: %v", diags)
+	}
+	warning := diags.Warnings()[0]
+	if warning.Summary() != "Check Run Failed in 1 of 2 Regions" {
+		t.Errorf("unexpected summary %q", warning.Summary())
+	}
+	want := "eu-west-1: failure in 3000ms at step login (status 500)\nus-east-1: success in 120ms"
+	if !strings.HasSuffix(warning.Detail(), want) {
+		t.Errorf("expected detail to end with %q, got %q", want, warning.Detail())
+	}
+}
+
+func TestRunCheckOnChange_OutlastsHTTPClientTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		fmt.Fprint(w, `{"data":[{"region":"us-east-1","status":"success","latency_ms":150}]}`)
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.HTTPClient.Timeout = 50 * time.Millisecond
+
+	var diags diag.Diagnostics
+	runCheckOnChange(context.Background(), c, &client.Check{ID: "chk-1"}, &diags)
+
+	if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Check Run Passed" {
+		t.Fatalf("expected the run to pass, got: %v", diags)
+	}
+}
+
+func TestCheckRunTimeout(t *testing.T) {
+	testCases := []struct {
+		name  string
+		check client.Check
+		want  time.Duration
+	}{
+		{"no timeout configured", client.Check{Type: "http"}, 90 * time.Second},
+		{"browser", client.Check{Type: "browser", Config: map[string]interface{}{"timeout_seconds": float64(300)}}, 6 * time.Minute},
+		{
+			"smtp-imap",
+			client.Check{Type: "smtp-imap", Config: map[string]interface{}{"timeout_seconds": float64(30), "max_wait_seconds": float64(900)}},
+			16*time.Minute + 30*time.Second,
+		},
+		{"milliseconds", client.Check{Type: "sse", Config: map[string]interface{}{"timeout_ms": "5000"}}, 65 * time.Second},
+		{
+			"staggered regions",
+			client.Check{Type: "browser", Config: map[string]interface{}{"timeout_seconds": float64(120)}, Regions: []string{"us-east-1", "eu-west-1"}},
+			5 * time.Minute,
+		},
+		{
+			"simultaneous regions",
+			client.Check{Type: "browser", Config: map[string]interface{}{"timeout_seconds": float64(120)}, Regions: []string{"us-east-1", "eu-west-1"}, SimultaneousRegions: true},
+			3 * time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := checkRunTimeout(&tc.check); got != tc.want {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRunOnChangeTriggered(t *testing.T) {
+	version := func(v string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue(v)})
+	}
+	null := types.MapNull(types.StringType)
+
+	testCases := []struct {
+		name           string
+		planned, prior types.Map
+		want           bool
+	}{
+		{"not set", null, null, false},
+		{"set on create", version("1.0"), null, true},
+		{"unchanged", version("1.0"), version("1.0"), false},
+		{"changed", version("1.1"), version("1.0"), true},
+		{"removed", null, version("1.0"), false},
+		{"unknown", types.MapUnknown(types.StringType), version("1.0"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := runOnChangeTriggered(tc.planned, tc.prior); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// scheduleDays lists the weekday names accepted in a schedule window.
+var scheduleDays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+var (
+	scheduleStartPattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+	scheduleEndPattern   = regexp.MustCompile(`^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$`)
+)
+
+// checkScheduleModel maps the schedule block.
+type checkScheduleModel struct {
+	Timezone                types.String               `tfsdk:"timezone"`
+	OffHoursIntervalSeconds types.Int64                `tfsdk:"off_hours_interval_seconds"`
+	Windows                 []checkScheduleWindowModel `tfsdk:"window"`
+}
+
+// checkScheduleWindowModel maps a window block within schedule.
+type checkScheduleWindowModel struct {
+	Days  types.Set    `tfsdk:"days"`
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+// validateCheckSchedule reports windows that end before they start, and windows
+// that overlap on the same weekday. Attribute formats are checked by validators.
+func validateCheckSchedule(ctx context.Context, schedule *checkScheduleModel, diags *diag.Diagnostics) {
+	type span struct {
+		index      int
+		start, end int
+	}
+	byDay := map[string][]span{}
+
+	for i, window := range schedule.Windows {
+		windowPath := path.Root("schedule").AtName("window").AtListIndex(i)
+		start, okStart := scheduleMinutes(window.Start)
+		end, okEnd := scheduleMinutes(window.End)
+		if !okStart || !okEnd {
+			continue
+		}
+		if end <= start {
+			diags.AddAttributeError(
+				windowPath.AtName("end"),
+				"Invalid Schedule Window",
+				fmt.Sprintf("end (%s) must be after start (%s). Split windows that span midnight into two windows.",
+					window.End.ValueString(), window.Start.ValueString()),
+			)
+			continue
+		}
+
+		if window.Days.IsNull() || window.Days.IsUnknown() {
+			continue
+		}
+		var days []string
+		diags.Append(window.Days.ElementsAs(ctx, &days, false)...)
+		for _, day := range days {
+			for _, other := range byDay[day] {
+				if start < other.end && other.start < end {
+					diags.AddAttributeError(
+						windowPath,
+						"Overlapping Schedule Windows",
+						fmt.Sprintf("window %d overlaps window %d on %s.", i, other.index, day),
+					)
+				}
+			}
+			byDay[day] = append(byDay[day], span{index: i, start: start, end: end})
+		}
+	}
+}
+
+// scheduleMinutes converts an HH:MM value to minutes since midnight. ok is false
+// if the value is unknown, null, or malformed.
+func scheduleMinutes(value types.String) (minutes int, ok bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return 0, false
+	}
+	hours, mins, found := strings.Cut(value.ValueString(), ":")
+	if !found {
+		return 0, false
+	}
+	h, errH := strconv.Atoi(hours)
+	m, errM := strconv.Atoi(mins)
+	if errH != nil || errM != nil {
+		return 0, false
+	}
+	return h*60 + m, true
+}
+
+// expandCheckSchedule converts the schedule block to its API representation.
+func expandCheckSchedule(ctx context.Context, schedule *checkScheduleModel) (*client.CheckSchedule, diag.Diagnostics) {
+	if schedule == nil {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+	result := &client.CheckSchedule{
+		Timezone: schedule.Timezone.ValueString(),
+		Windows:  make([]client.CheckScheduleWindow, 0, len(schedule.Windows)),
+	}
+	if !schedule.OffHoursIntervalSeconds.IsNull() {
+		interval := int(schedule.OffHoursIntervalSeconds.ValueInt64())
+		result.OffHoursIntervalSeconds = &interval
+	}
+	for _, window := range schedule.Windows {
+		var days []string
+		diags.Append(window.Days.ElementsAs(ctx, &days, false)...)
+		result.Windows = append(result.Windows, client.CheckScheduleWindow{
+			Days:  days,
+			Start: window.Start.ValueString(),
+			End:   window.End.ValueString(),
+		})
+	}
+	return result, diags
+}
+
+// flattenCheckSchedule converts an API schedule to the schedule block.
+func flattenCheckSchedule(ctx context.Context, schedule *client.CheckSchedule) (*checkScheduleModel, diag.Diagnostics) {
+	if schedule == nil {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+	result := &checkScheduleModel{
+		Timezone:                types.StringValue(schedule.Timezone),
+		OffHoursIntervalSeconds: types.Int64Null(),
+		Windows:                 make([]checkScheduleWindowModel, 0, len(schedule.Windows)),
+	}
+	if schedule.OffHoursIntervalSeconds != nil {
+		result.OffHoursIntervalSeconds = types.Int64Value(int64(*schedule.OffHoursIntervalSeconds))
+	}
+	for _, window := range schedule.Windows {
+		days, d := types.SetValueFrom(ctx, types.StringType, window.Days)
+		diags.Append(d...)
+		result.Windows = append(result.Windows, checkScheduleWindowModel{
+			Days:  days,
+			Start: types.StringValue(window.Start),
+			End:   types.StringValue(window.End),
+		})
+	}
+	return result, diags
+}
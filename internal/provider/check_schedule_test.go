@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+func testScheduleWindow(start, end string, days ...string) checkScheduleWindowModel {
+	daySet, _ := types.SetValueFrom(context.Background(), types.StringType, days)
+	return checkScheduleWindowModel{
+		Days:  daySet,
+		Start: types.StringValue(start),
+		End:   types.StringValue(end),
+	}
+}
+
+func TestValidateCheckSchedule(t *testing.T) {
+	testCases := []struct {
+		name    string
+		windows []checkScheduleWindowModel
+		wantErr string
+	}{
+		{"business hours", []checkScheduleWindowModel{
+			testScheduleWindow("08:00", "18:00", "mon", "tue", "wed", "thu", "fri"),
+		}, ""},
+		{"batch window", []checkScheduleWindowModel{
+			testScheduleWindow("02:00", "04:00", scheduleDays...),
+		}, ""},
+		{"adjacent windows", []checkScheduleWindowModel{
+			testScheduleWindow("00:00", "08:00", "mon"),
+			testScheduleWindow("08:00", "24:00", "mon"),
+		}, ""},
+		{"same hours on other days", []checkScheduleWindowModel{
+			testScheduleWindow("08:00", "18:00", "mon"),
+			testScheduleWindow("08:00", "18:00", "tue"),
+		}, ""},
+		{"end before start", []checkScheduleWindowModel{
+			testScheduleWindow("22:00", "02:00", "fri"),
+		}, "must be after start"},
+		{"empty window", []checkScheduleWindowModel{
+			testScheduleWindow("08:00", "08:00", "fri"),
+		}, "must be after start"},
+		{"overlapping windows", []checkScheduleWindowModel{
+			testScheduleWindow("08:00", "18:00", "mon", "tue"),
+			testScheduleWindow("17:00", "20:00", "tue"),
+		}, "window 1 overlaps window 0 on tue"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateCheckSchedule(context.Background(), &checkScheduleModel{
+				Timezone: types.StringValue("Europe/Berlin"),
+				Windows:  tc.windows,
+			}, &diags)
+
+			if tc.wantErr == "" {
+				if diags.HasError() {
+					t.Errorf("expected no errors, got: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tc.wantErr) {
+				t.Errorf("expected an error containing %q, got: %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestCheckScheduleRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	offHours := 3600
+	schedule := &client.CheckSchedule{
+		Timezone: "America/New_York",
+		Windows: []client.CheckScheduleWindow{
+			{Days: []string{"mon", "fri"}, Start: "09:00", End: "17:30"},
+		},
+		OffHoursIntervalSeconds: &offHours,
+	}
+
+	model, diags := flattenCheckSchedule(ctx, schedule)
+	if diags.HasError() {
+		t.Fatalf("flatten: %v", diags)
+	}
+	expanded, diags := expandCheckSchedule(ctx, model)
+	if diags.HasError() {
+		t.Fatalf("expand: %v", diags)
+	}
+	if !reflect.DeepEqual(expanded, schedule) {
+		t.Errorf("round trip changed the schedule: got %+v, want %+v", expanded, schedule)
+	}
+
+	if model, _ := flattenCheckSchedule(ctx, nil); model != nil {
+		t.Error("expected no schedule block for a check without a schedule")
+	}
+}
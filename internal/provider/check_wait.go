@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+const (
+	defaultWaitForHealthyTimeout    = 5 * time.Minute
+	defaultWaitForHealthyMinRegions = 1
+)
+
+// waitForHealthyPollInterval is how often the check is polled while waiting. It is
+// a variable so unit tests can shorten it.
+var waitForHealthyPollInterval = 5 * time.Second
+
+// checkWaitForHealthyModel maps the wait_for_healthy block.
+type checkWaitForHealthyModel struct {
+	Timeout    types.String `tfsdk:"timeout"`
+	MinRegions types.Int64  `tfsdk:"min_regions"`
+}
+
+// settings returns the configured timeout and minimum region count, applying defaults.
+func (m *checkWaitForHealthyModel) settings() (time.Duration, int) {
+	timeout := defaultWaitForHealthyTimeout
+	if !m.Timeout.IsNull() && !m.Timeout.IsUnknown() {
+		// Validated at plan time
+		if d, err := time.ParseDuration(m.Timeout.ValueString()); err == nil {
+			timeout = d
+		}
+	}
+	minRegions := defaultWaitForHealthyMinRegions
+	if !m.MinRegions.IsNull() && !m.MinRegions.IsUnknown() {
+		minRegions = int(m.MinRegions.ValueInt64())
+	}
+	return timeout, minRegions
+}
+
+// waitForCheckHealthy polls a check until at least minRegions regions report a
+// successful run since the given time and the check is healthy. It returns the
+// latest check on success, or an error describing the last failures on timeout.
+func waitForCheckHealthy(ctx context.Context, c *client.Client, checkID string, since time.Time, timeout time.Duration, minRegions int) (*client.Check, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(waitForHealthyPollInterval)
+	defer ticker.Stop()
+
+	lastProblem := "no results reported yet"
+	for {
+		check, problem, err := checkHealthyOnce(c, checkID, since, minRegions)
+		if err != nil {
+			return nil, err
+		}
+		if problem == "" {
+			return check, nil
+		}
+		lastProblem = problem
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("check %s did not become healthy within %s: %s", checkID, timeout, lastProblem)
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkHealthyOnce fetches the check and its latest results. problem is empty when
+// the check is healthy, and otherwise describes why it is not (yet).
+func checkHealthyOnce(c *client.Client, checkID string, since time.Time, minRegions int) (check *client.Check, problem string, err error) {
+	check, err = c.GetCheck(checkID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	results, err := c.GetCheckResults(checkID, client.CheckResultsOptions{
+		LimitPerRegion: 1,
+		Since:          since.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	healthyRegions := 0
+	var failures []string
+	for _, result := range results {
+		if result.Status == "success" {
+			healthyRegions++
+			continue
+		}
+		failure := fmt.Sprintf("%s: %s", result.Region, result.Status)
+		if result.FailingStep != "" {
+			failure += " at step " + result.FailingStep
+		}
+		if result.Error != "" {
+			failure += " (" + result.Error + ")"
+		}
+		failures = append(failures, failure)
+	}
+	sort.Strings(failures)
+
+	switch {
+	case healthyRegions >= minRegions && check.HealthStatus == "healthy":
+		return check, "", nil
+	case len(failures) > 0:
+		return check, fmt.Sprintf("%d of %d required regions healthy; last failures: %s",
+			healthyRegions, minRegions, strings.Join(failures, "; ")), nil
+	default:
+		return check, fmt.Sprintf("%d of %d required regions healthy, health_status is %q",
+			healthyRegions, minRegions, check.HealthStatus), nil
+	}
+}
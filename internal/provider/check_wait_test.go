@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// newWaitTestServer serves a check whose results become successful in every region
+// after failingPolls polls of the results endpoint.
+func newWaitTestServer(t *testing.T, failingPolls int32) *client.Client {
+	t.Helper()
+
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/checks/chk-1":
+			health := "unhealthy"
+			if atomic.LoadInt32(&polls) > failingPolls {
+				health = "healthy"
+			}
+			fmt.Fprintf(w, `{"data":{"id":"chk-1","health_status":%q}}`, health)
+		case r.URL.Path == "/v1/checks/chk-1/results":
+			if r.URL.Query().Get("since") == "" {
+				t.Errorf("expected results to be filtered by since")
+			}
+			if atomic.AddInt32(&polls, 1) <= failingPolls {
+				fmt.Fprint(w, `{"data":[`+
+					`{"region":"us-east","status":"success"},`+
+					`{"region":"eu-west","status":"failure","error":"connection refused"}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[{"region":"us-east","status":"success"},{"region":"eu-west","status":"success"}]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.New(server.URL, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestWaitForCheckHealthy(t *testing.T) {
+	defer func(interval time.Duration) { waitForHealthyPollInterval = interval }(waitForHealthyPollInterval)
+	waitForHealthyPollInterval = 10 * time.Millisecond
+
+	c := newWaitTestServer(t, 2)
+	check, err := waitForCheckHealthy(context.Background(), c, "chk-1", time.Now(), time.Second, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if check.HealthStatus != "healthy" {
+		t.Errorf("expected health_status healthy, got %q", check.HealthStatus)
+	}
+}
+
+func TestWaitForCheckHealthy_Timeout(t *testing.T) {
+	defer func(interval time.Duration) { waitForHealthyPollInterval = interval }(waitForHealthyPollInterval)
+	waitForHealthyPollInterval = 10 * time.Millisecond
+
+	c := newWaitTestServer(t, 1000)
+	_, err := waitForCheckHealthy(context.Background(), c, "chk-1", time.Now(), 50*time.Millisecond, 2)
+	if err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	for _, want := range []string{"did not become healthy", "1 of 2 required regions", "eu-west: failure (connection refused)"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to contain %q, got: %s", want, err)
+		}
+	}
+}
+
+func TestCheckWaitForHealthySettings(t *testing.T) {
+	var m checkWaitForHealthyModel
+	timeout, minRegions := m.settings()
+	if timeout != defaultWaitForHealthyTimeout || minRegions != defaultWaitForHealthyMinRegions {
+		t.Errorf("expected defaults, got %s and %d", timeout, minRegions)
+	}
+}
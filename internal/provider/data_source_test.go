@@ -62,6 +62,72 @@ func TestAccNotificationChannelDataSource(t *testing.T) {
 	})
 }
 
+func TestAccOrganizationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "quismon_organization" "current" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.quismon_organization.current", "id"),
+					resource.TestCheckResourceAttrSet("data.quismon_organization.current", "name"),
+					resource.TestCheckResourceAttrSet("data.quismon_organization.current", "tier"),
+					resource.TestCheckResourceAttrSet("data.quismon_organization.current", "limits.min_interval_seconds"),
+					resource.TestCheckResourceAttrSet("data.quismon_organization.current", "check_count"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIncidentsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentConfig("identified", `["Root cause identified."]`) + `
+data "quismon_incidents" "open" {
+  status_page_id = quismon_incident.test.status_page_id
+  state          = "open"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.quismon_incidents.open", "open_count", "1"),
+					resource.TestCheckResourceAttr("data.quismon_incidents.open", "incidents.#", "1"),
+					resource.TestCheckResourceAttr("data.quismon_incidents.open", "incidents.0.status", "identified"),
+					resource.TestCheckResourceAttr("data.quismon_incidents.open", "incidents.0.latest_update", "Root cause identified."),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCheckResultsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceConfig() + `
+data "quismon_check_results" "test" {
+  check_id         = quismon_check.test.id
+  window_hours     = 6
+  limit_per_region = 5
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.quismon_check_results.test", "window_hours", "6"),
+					resource.TestCheckResourceAttrSet("data.quismon_check_results.test", "uptime_percent"),
+					resource.TestCheckResourceAttrSet("data.quismon_check_results.test", "total_runs"),
+					resource.TestCheckResourceAttrSet("data.quismon_check_results.test", "results.#"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDataSourceConfig() string {
 	return `
 resource "quismon_check" "test" {
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// durationValidator validates that a string attribute holds a positive Go
+// duration, e.g. 90s or 5m.
+type durationValidator struct{}
+
+// Description returns a human-readable description of the validator.
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration (e.g. 90s, 5m, 1h)"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v durationValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a positive duration (e.g. `90s`, `5m`, `1h`)"
+}
+
+// ValidateString implements the validator interface.
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			"Expected a positive duration such as 90s, 5m or 1h, got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+// Duration returns a validator which ensures the value is a positive duration.
+func Duration() validator.String {
+	return durationValidator{}
+}
@@ -0,0 +1,743 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// exportSinkTypes lists the supported export sink destinations. Each has a nested
+// attribute of the same name.
+var exportSinkTypes = []string{"s3", "splunk_hec", "loki", "webhook"}
+
+// exportSinkURLRegexp matches the http(s) URLs accepted for HTTP-based sinks.
+var exportSinkURLRegexp = regexp.MustCompile(`^https?://`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &exportSinkResource{}
+	_ resource.ResourceWithConfigure      = &exportSinkResource{}
+	_ resource.ResourceWithImportState    = &exportSinkResource{}
+	_ resource.ResourceWithModifyPlan     = &exportSinkResource{}
+	_ resource.ResourceWithValidateConfig = &exportSinkResource{}
+)
+
+// NewExportSinkResource is a helper function to simplify the provider implementation.
+func NewExportSinkResource() resource.Resource {
+	return &exportSinkResource{}
+}
+
+// exportSinkResource is the resource implementation.
+type exportSinkResource struct {
+	client *client.Client
+}
+
+// exportSinkResourceModel maps the resource schema data.
+type exportSinkResourceModel struct {
+	ID         types.String            `tfsdk:"id"`
+	OrgID      types.String            `tfsdk:"org_id"`
+	Name       types.String            `tfsdk:"name"`
+	Type       types.String            `tfsdk:"type"`
+	Enabled    types.Bool              `tfsdk:"enabled"`
+	EventTypes types.Set               `tfsdk:"event_types"`
+	CheckTags  types.Set               `tfsdk:"check_tags"`
+	S3         *exportSinkS3Model      `tfsdk:"s3"`
+	SplunkHEC  *exportSinkSplunkModel  `tfsdk:"splunk_hec"`
+	Loki       *exportSinkLokiModel    `tfsdk:"loki"`
+	Webhook    *exportSinkWebhookModel `tfsdk:"webhook"`
+	SecretHash types.String            `tfsdk:"secret_hash"`
+	CreatedAt  types.String            `tfsdk:"created_at"`
+	UpdatedAt  types.String            `tfsdk:"updated_at"`
+}
+
+// exportSinkS3Model maps the nested s3 object.
+type exportSinkS3Model struct {
+	Bucket          types.String `tfsdk:"bucket"`
+	Region          types.String `tfsdk:"region"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Prefix          types.String `tfsdk:"prefix"`
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	ForcePathStyle  types.Bool   `tfsdk:"force_path_style"`
+}
+
+// exportSinkSplunkModel maps the nested splunk_hec object.
+type exportSinkSplunkModel struct {
+	URL        types.String `tfsdk:"url"`
+	Token      types.String `tfsdk:"token"`
+	Index      types.String `tfsdk:"index"`
+	SourceType types.String `tfsdk:"source_type"`
+}
+
+// exportSinkLokiModel maps the nested loki object.
+type exportSinkLokiModel struct {
+	URL      types.String `tfsdk:"url"`
+	TenantID types.String `tfsdk:"tenant_id"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Labels   types.Map    `tfsdk:"labels"`
+}
+
+// exportSinkWebhookModel maps the nested webhook object.
+type exportSinkWebhookModel struct {
+	URL       types.String `tfsdk:"url"`
+	Headers   types.Map    `tfsdk:"headers"`
+	BatchSize types.Int64  `tfsdk:"batch_size"`
+}
+
+// Metadata returns the resource type name.
+func (r *exportSinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_export_sink"
+}
+
+// Schema defines the schema for the resource.
+func (r *exportSinkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	urlValidators := []validator.String{
+		stringvalidator.RegexMatches(exportSinkURLRegexp, "must be an http:// or https:// URL"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Ships raw check results and alert state transitions as events to S3-compatible storage, Splunk HEC, Grafana Loki or a generic NDJSON webhook. " +
+			"This is a PAID feature and requires a 'paid' or 'enterprise' tier subscription.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Export sink ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "Organization ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the export sink.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Destination type: s3, splunk_hec, loki, or webhook. The nested attribute of the same name must be set. Changing this forces a new resource.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(exportSinkTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether events are exported. Default is true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"event_types": schema.SetAttribute{
+				Description: "Events to export: check_result (every check run in every region) and/or alert_transition (an alert rule firing or resolving). Default is both.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue(client.ExportEventCheckResult),
+					types.StringValue(client.ExportEventAlertTransition),
+				})),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(client.ExportEventCheckResult, client.ExportEventAlertTransition)),
+				},
+			},
+			"check_tags": schema.SetAttribute{
+				Description: "Only export events of checks with at least one of these tags (see the tags attribute of quismon_check). Exports events of all checks if not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"s3": schema.SingleNestedAttribute{
+				Description: "Write gzipped NDJSON batches to an S3-compatible bucket. Required when type is s3.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"bucket": schema.StringAttribute{
+						Description: "Bucket name.",
+						Required:    true,
+					},
+					"region": schema.StringAttribute{
+						Description: "Bucket region (e.g., eu-west-1).",
+						Optional:    true,
+					},
+					"endpoint": schema.StringAttribute{
+						Description: "Endpoint URL for S3-compatible storage such as MinIO or Cloudflare R2. Uses AWS S3 if not set.",
+						Optional:    true,
+						Validators:  urlValidators,
+					},
+					"prefix": schema.StringAttribute{
+						Description: "Key prefix for exported objects (e.g., quismon/events/).",
+						Optional:    true,
+					},
+					"access_key_id": schema.StringAttribute{
+						Description: "Access key ID.",
+						Required:    true,
+					},
+					"secret_access_key": schema.StringAttribute{
+						Description: "Secret access key of access_key_id. The API does not return it; if the key is rotated outside Terraform, secret_hash changes and the next apply sets it back.",
+						Required:    true,
+						Sensitive:   true,
+					},
+					"force_path_style": schema.BoolAttribute{
+						Description: "Use path-style addressing (endpoint/bucket/key), as most S3-compatible servers require. Default is false.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+			"splunk_hec": schema.SingleNestedAttribute{
+				Description: "Send events to a Splunk HTTP Event Collector. Required when type is splunk_hec.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "HEC URL (e.g., https://splunk.example.com:8088).",
+						Required:    true,
+						Validators:  urlValidators,
+					},
+					"token": schema.StringAttribute{
+						Description: "HEC token. Only its hash is returned, so a token replaced in Splunk or the dashboard shows up as a change of secret_hash and is restored on the next apply.",
+						Required:    true,
+						Sensitive:   true,
+					},
+					"index": schema.StringAttribute{
+						Description: "Index to write to. Uses the token's default index if not set.",
+						Optional:    true,
+					},
+					"source_type": schema.StringAttribute{
+						Description: "Sourcetype of the events. Uses the token's default if not set.",
+						Optional:    true,
+					},
+				},
+			},
+			"loki": schema.SingleNestedAttribute{
+				Description: "Push events to Grafana Loki as log lines. Required when type is loki.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "Loki push URL (e.g., https://loki.example.com/loki/api/v1/push).",
+						Required:    true,
+						Validators:  urlValidators,
+					},
+					"tenant_id": schema.StringAttribute{
+						Description: "Tenant sent in the X-Scope-OrgID header for multi-tenant Loki.",
+						Optional:    true,
+					},
+					"username": schema.StringAttribute{
+						Description: "Basic authentication username.",
+						Optional:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "Basic authentication password for username. Covered by secret_hash: a password changed outside Terraform is reported on refresh and sent again on the next apply.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"labels": schema.MapAttribute{
+						Description: "Static stream labels added to every log line, in addition to check_id, check_type and event_type.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"webhook": schema.SingleNestedAttribute{
+				Description: "POST batches of events as newline-delimited JSON. Required when type is webhook.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "URL the batches are POSTed to.",
+						Required:    true,
+						Validators:  urlValidators,
+					},
+					"headers": schema.MapAttribute{
+						Description: "HTTP headers sent with every request, e.g. for authentication. The API only returns header names; changed values are detected via secret_hash.",
+						Optional:    true,
+						Sensitive:   true,
+						ElementType: types.StringType,
+					},
+					"batch_size": schema.Int64Attribute{
+						Description: "Maximum number of events per request (1-1000, default 100).",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(100),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 1000),
+						},
+					},
+				},
+			},
+			"secret_hash": schema.StringAttribute{
+				Description: "Hash of the destination's write-only values (S3 secret access key, HEC token, Loki password or webhook header values), used to detect changes made outside Terraform.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *exportSinkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that exactly the destination matching type is configured.
+func (r *exportSinkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config exportSinkResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+
+	sinkType := config.Type.ValueString()
+	configured := map[string]bool{
+		"s3":         config.S3 != nil,
+		"splunk_hec": config.SplunkHEC != nil,
+		"loki":       config.Loki != nil,
+		"webhook":    config.Webhook != nil,
+	}
+	for _, name := range exportSinkTypes {
+		switch {
+		case name == sinkType && !configured[name]:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Export Sink Destination",
+				fmt.Sprintf("The %s attribute is required when type is %q.", name, sinkType),
+			)
+		case name != sinkType && configured[name]:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unexpected Export Sink Destination",
+				fmt.Sprintf("The %s attribute cannot be used when type is %q.", name, sinkType),
+			)
+		}
+	}
+
+	if config.Loki != nil && !config.Loki.Password.IsNull() && config.Loki.Username.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("loki").AtName("username"),
+			"Missing Loki Username",
+			"username is required when password is set.",
+		)
+	}
+}
+
+// ModifyPlan keeps secret_hash known while the destination's secrets are
+// unchanged, and fails the plan early when the organization's tier does not
+// include event export.
+func (r *exportSinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var plan, state exportSinkResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if sameExportSinkSecrets(plan, state) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_hash"), state.SecretHash)...)
+		}
+	}
+
+	if r.client == nil || r.client.Organization == nil {
+		return
+	}
+
+	// Don't fail plans for an unchanged sink, e.g. after a downgrade
+	if !req.State.Raw.IsNull() && resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	resp.Diagnostics.Append(checkPlanFeature(r.client.Organization, "export_sinks", "Event export", path.Root("type"))...)
+}
+
+// Create creates the resource and sets initial Terraform state.
+func (r *exportSinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan exportSinkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var eventTypes, checkTags []string
+	resp.Diagnostics.Append(plan.EventTypes.ElementsAs(ctx, &eventTypes, false)...)
+	if !plan.CheckTags.IsNull() {
+		resp.Diagnostics.Append(plan.CheckTags.ElementsAs(ctx, &checkTags, false)...)
+	}
+	destination := expandExportSinkDestination(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := client.CreateExportSinkRequest{
+		Name:       plan.Name.ValueString(),
+		Type:       plan.Type.ValueString(),
+		Enabled:    plan.Enabled.ValueBool(),
+		EventTypes: eventTypes,
+		CheckTags:  checkTags,
+		S3:         destination.S3,
+		SplunkHEC:  destination.SplunkHEC,
+		Loki:       destination.Loki,
+		Webhook:    destination.Webhook,
+	}
+
+	sink, err := r.client.CreateExportSink(createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Export Sink",
+			"Could not create export sink, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(sink.ID)
+	mapExportSinkComputed(sink, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *exportSinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state exportSinkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sink, err := r.client.GetExportSink(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Export Sink",
+			"Could not read export sink ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	flattenExportSink(ctx, sink, &state, &resp.Diagnostics)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *exportSinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan exportSinkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventTypes := []string{}
+	checkTags := []string{}
+	resp.Diagnostics.Append(plan.EventTypes.ElementsAs(ctx, &eventTypes, false)...)
+	if !plan.CheckTags.IsNull() {
+		resp.Diagnostics.Append(plan.CheckTags.ElementsAs(ctx, &checkTags, false)...)
+	}
+	destination := expandExportSinkDestination(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	enabled := plan.Enabled.ValueBool()
+	updateReq := client.UpdateExportSinkRequest{
+		Name:       &name,
+		Enabled:    &enabled,
+		EventTypes: &eventTypes,
+		CheckTags:  &checkTags,
+		S3:         destination.S3,
+		SplunkHEC:  destination.SplunkHEC,
+		Loki:       destination.Loki,
+		Webhook:    destination.Webhook,
+	}
+
+	sink, err := r.client.UpdateExportSink(plan.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Export Sink",
+			"Could not update export sink, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapExportSinkComputed(sink, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *exportSinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state exportSinkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteExportSink(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Export Sink",
+			"Could not delete export sink, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state. Secrets cannot be imported and are null
+// after import.
+func (r *exportSinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapExportSinkComputed copies the computed fields of an API response into the model.
+func mapExportSinkComputed(sink *client.ExportSink, model *exportSinkResourceModel) {
+	model.OrgID = types.StringValue(sink.OrgID)
+	model.SecretHash = types.StringValue(sink.SecretHash)
+	model.CreatedAt = types.StringValue(sink.CreatedAt)
+	model.UpdatedAt = types.StringValue(sink.UpdatedAt)
+}
+
+// expandExportSinkDestination converts the configured destination into its API
+// representation. Only the destination matching the model's type is set.
+func expandExportSinkDestination(ctx context.Context, model exportSinkResourceModel, diags *diag.Diagnostics) client.ExportSink {
+	var sink client.ExportSink
+
+	switch model.Type.ValueString() {
+	case "s3":
+		if s3 := model.S3; s3 != nil {
+			sink.S3 = &client.ExportSinkS3{
+				Bucket:          s3.Bucket.ValueString(),
+				Region:          s3.Region.ValueString(),
+				Endpoint:        s3.Endpoint.ValueString(),
+				Prefix:          s3.Prefix.ValueString(),
+				AccessKeyID:     s3.AccessKeyID.ValueString(),
+				SecretAccessKey: s3.SecretAccessKey.ValueString(),
+				ForcePathStyle:  s3.ForcePathStyle.ValueBool(),
+			}
+		}
+	case "splunk_hec":
+		if splunk := model.SplunkHEC; splunk != nil {
+			sink.SplunkHEC = &client.ExportSinkSplunk{
+				URL:        splunk.URL.ValueString(),
+				Token:      splunk.Token.ValueString(),
+				Index:      splunk.Index.ValueString(),
+				SourceType: splunk.SourceType.ValueString(),
+			}
+		}
+	case "loki":
+		if loki := model.Loki; loki != nil {
+			sink.Loki = &client.ExportSinkLoki{
+				URL:      loki.URL.ValueString(),
+				TenantID: loki.TenantID.ValueString(),
+				Username: loki.Username.ValueString(),
+				Password: loki.Password.ValueString(),
+			}
+			if !loki.Labels.IsNull() {
+				diags.Append(loki.Labels.ElementsAs(ctx, &sink.Loki.Labels, false)...)
+			}
+		}
+	case "webhook":
+		if webhook := model.Webhook; webhook != nil {
+			sink.Webhook = &client.ExportSinkWebhook{
+				URL:       webhook.URL.ValueString(),
+				BatchSize: int(webhook.BatchSize.ValueInt64()),
+			}
+			if !webhook.Headers.IsNull() {
+				diags.Append(webhook.Headers.ElementsAs(ctx, &sink.Webhook.Headers, false)...)
+			}
+		}
+	}
+
+	return sink
+}
+
+// flattenExportSink maps an API export sink into the model. Secrets are write-only,
+// so they are kept from the model; webhook headers are kept as long as the API
+// reports the same header names. If secret_hash changed since the last apply, the
+// secrets were modified outside Terraform and are forgotten so the next plan
+// restores them.
+func flattenExportSink(ctx context.Context, sink *client.ExportSink, model *exportSinkResourceModel, diags *diag.Diagnostics) {
+	keepSecrets := !secretHashChanged(model.SecretHash, sink.SecretHash)
+	if !keepSecrets {
+		diags.AddWarning(
+			"Export Sink Secret Drift Detected",
+			"The secrets of export sink "+sink.Name+" have been modified outside of Terraform. "+
+				"The secret_hash changed from "+model.SecretHash.ValueString()+" to "+sink.SecretHash+". "+
+				"The next apply resets them to your Terraform-defined values.",
+		)
+	}
+
+	model.ID = types.StringValue(sink.ID)
+	model.Name = types.StringValue(sink.Name)
+	model.Type = types.StringValue(sink.Type)
+	model.Enabled = types.BoolValue(sink.Enabled)
+
+	eventTypes, d := types.SetValueFrom(ctx, types.StringType, sink.EventTypes)
+	diags.Append(d...)
+	model.EventTypes = eventTypes
+	if len(sink.CheckTags) > 0 {
+		checkTags, d := types.SetValueFrom(ctx, types.StringType, sink.CheckTags)
+		diags.Append(d...)
+		model.CheckTags = checkTags
+	} else {
+		model.CheckTags = types.SetNull(types.StringType)
+	}
+
+	current := *model
+	model.S3, model.SplunkHEC, model.Loki, model.Webhook = nil, nil, nil, nil
+
+	if s3 := sink.S3; s3 != nil {
+		model.S3 = &exportSinkS3Model{
+			Bucket:          types.StringValue(s3.Bucket),
+			Region:          optionalString(s3.Region),
+			Endpoint:        optionalString(s3.Endpoint),
+			Prefix:          optionalString(s3.Prefix),
+			AccessKeyID:     types.StringValue(s3.AccessKeyID),
+			SecretAccessKey: types.StringNull(),
+			ForcePathStyle:  types.BoolValue(s3.ForcePathStyle),
+		}
+		if current.S3 != nil && keepSecrets {
+			model.S3.SecretAccessKey = current.S3.SecretAccessKey
+		}
+	}
+
+	if splunk := sink.SplunkHEC; splunk != nil {
+		model.SplunkHEC = &exportSinkSplunkModel{
+			URL:        types.StringValue(splunk.URL),
+			Token:      types.StringNull(),
+			Index:      optionalString(splunk.Index),
+			SourceType: optionalString(splunk.SourceType),
+		}
+		if current.SplunkHEC != nil && keepSecrets {
+			model.SplunkHEC.Token = current.SplunkHEC.Token
+		}
+	}
+
+	if loki := sink.Loki; loki != nil {
+		model.Loki = &exportSinkLokiModel{
+			URL:      types.StringValue(loki.URL),
+			TenantID: optionalString(loki.TenantID),
+			Username: optionalString(loki.Username),
+			Password: types.StringNull(),
+			Labels:   types.MapNull(types.StringType),
+		}
+		if current.Loki != nil && loki.Username != "" && keepSecrets {
+			model.Loki.Password = current.Loki.Password
+		}
+		if len(loki.Labels) > 0 {
+			labels, d := types.MapValueFrom(ctx, types.StringType, loki.Labels)
+			diags.Append(d...)
+			model.Loki.Labels = labels
+		}
+	}
+
+	if webhook := sink.Webhook; webhook != nil {
+		model.Webhook = &exportSinkWebhookModel{
+			URL:       types.StringValue(webhook.URL),
+			Headers:   types.MapNull(types.StringType),
+			BatchSize: types.Int64Value(int64(webhook.BatchSize)),
+		}
+		if len(webhook.Headers) > 0 {
+			// Values are redacted; keep the configured values unless the set of
+			// header names changed, in which case the redacted values force a diff
+			headers, d := types.MapValueFrom(ctx, types.StringType, webhook.Headers)
+			diags.Append(d...)
+			model.Webhook.Headers = headers
+			if current.Webhook != nil && keepSecrets && sameMapKeys(current.Webhook.Headers, webhook.Headers) {
+				model.Webhook.Headers = current.Webhook.Headers
+			}
+		}
+	}
+
+	model.SecretHash = types.StringValue(sink.SecretHash)
+}
+
+// sameExportSinkSecrets reports whether two models have the same type and
+// destination secrets.
+func sameExportSinkSecrets(a, b exportSinkResourceModel) bool {
+	if !a.Type.Equal(b.Type) {
+		return false
+	}
+	switch {
+	case a.S3 != nil && b.S3 != nil:
+		return a.S3.SecretAccessKey.Equal(b.S3.SecretAccessKey)
+	case a.SplunkHEC != nil && b.SplunkHEC != nil:
+		return a.SplunkHEC.Token.Equal(b.SplunkHEC.Token)
+	case a.Loki != nil && b.Loki != nil:
+		return a.Loki.Username.Equal(b.Loki.Username) && a.Loki.Password.Equal(b.Loki.Password)
+	case a.Webhook != nil && b.Webhook != nil:
+		return a.Webhook.Headers.Equal(b.Webhook.Headers)
+	}
+	return false
+}
+
+// sameMapKeys reports whether a Terraform string map has exactly the given keys.
+func sameMapKeys(m types.Map, keys map[string]string) bool {
+	if m.IsNull() || m.IsUnknown() || len(m.Elements()) != len(keys) {
+		return false
+	}
+	for key := range m.Elements() {
+		if _, ok := keys[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
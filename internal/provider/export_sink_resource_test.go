@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// exportSinkStandIn serves /v1/export-sinks from memory. Like the real API it
+// stores secrets but only returns their hash, with webhook header values redacted.
+type exportSinkStandIn struct {
+	sinks      map[string]client.ExportSink
+	lastUpdate client.UpdateExportSinkRequest
+}
+
+func (s *exportSinkStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/v1/export-sinks/")
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/export-sinks":
+		var req client.CreateExportSinkRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		sink := client.ExportSink{
+			ID:         fmt.Sprintf("sink-%d", len(s.sinks)+1),
+			OrgID:      "org-1",
+			Name:       req.Name,
+			Type:       req.Type,
+			Enabled:    req.Enabled,
+			EventTypes: req.EventTypes,
+			CheckTags:  req.CheckTags,
+			S3:         req.S3,
+			SplunkHEC:  req.SplunkHEC,
+			Loki:       req.Loki,
+			Webhook:    req.Webhook,
+			CreatedAt:  "2026-01-01T00:00:00Z",
+			UpdatedAt:  "2026-01-01T00:00:00Z",
+		}
+		s.sinks[sink.ID] = sink
+		s.respond(w, sink)
+
+	case r.Method == http.MethodPut && s.sinks[id].ID != "":
+		var req client.UpdateExportSinkRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.lastUpdate = req
+
+		sink := s.sinks[id]
+		sink.Name = *req.Name
+		sink.Enabled = *req.Enabled
+		sink.EventTypes = *req.EventTypes
+		sink.CheckTags = *req.CheckTags
+		sink.S3, sink.SplunkHEC, sink.Loki, sink.Webhook = req.S3, req.SplunkHEC, req.Loki, req.Webhook
+		sink.UpdatedAt = "2026-01-02T00:00:00Z"
+		s.sinks[id] = sink
+		s.respond(w, sink)
+
+	case r.Method == http.MethodGet && s.sinks[id].ID != "":
+		s.respond(w, s.sinks[id])
+
+	case r.Method == http.MethodDelete && s.sinks[id].ID != "":
+		delete(s.sinks, id)
+		fmt.Fprint(w, `{"data":null}`)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// respond writes a sink the way the API returns it: secrets replaced by their hash.
+func (s *exportSinkStandIn) respond(w http.ResponseWriter, sink client.ExportSink) {
+	secrets := sha256.New()
+	if sink.S3 != nil {
+		s3 := *sink.S3
+		fmt.Fprint(secrets, s3.SecretAccessKey)
+		s3.SecretAccessKey = ""
+		sink.S3 = &s3
+	}
+	if sink.Webhook != nil {
+		webhook := *sink.Webhook
+		webhook.Headers = map[string]string{}
+		for _, name := range slices.Sorted(maps.Keys(sink.Webhook.Headers)) {
+			fmt.Fprintf(secrets, "%s=%s\n", name, sink.Webhook.Headers[name])
+			webhook.Headers[name] = "***REDACTED***"
+		}
+		sink.Webhook = &webhook
+	}
+	sink.SecretHash = hex.EncodeToString(secrets.Sum(nil))[:16]
+	data, _ := json.Marshal(sink)
+	fmt.Fprintf(w, `{"data":%s}`, data)
+}
+
+func TestExportSinkResource_StandIn(t *testing.T) {
+	standIn := &exportSinkStandIn{sinks: map[string]client.ExportSink{}}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	c, err := client.New(server.URL, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newResourceHarness(t, NewExportSinkResource(), c)
+
+	headers := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Authorization": types.StringValue("Bearer secret"),
+	})
+	configured := exportSinkResourceModel{
+		ID:      types.StringUnknown(),
+		OrgID:   types.StringUnknown(),
+		Name:    types.StringValue("events"),
+		Type:    types.StringValue("webhook"),
+		Enabled: types.BoolValue(true),
+		EventTypes: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue(client.ExportEventAlertTransition),
+		}),
+		CheckTags: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("production"),
+		}),
+		Webhook: &exportSinkWebhookModel{
+			URL:       types.StringValue("https://events.example.com/ingest"),
+			Headers:   headers,
+			BatchSize: types.Int64Value(50),
+		},
+		SecretHash: types.StringUnknown(),
+		CreatedAt:  types.StringUnknown(),
+		UpdatedAt:  types.StringUnknown(),
+	}
+
+	// Create
+	state, diags := h.create(h.plan(configured))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics after create: %v", diags)
+	}
+	var model exportSinkResourceModel
+	h.model(state, &model)
+	if got := standIn.sinks[model.ID.ValueString()].Webhook.Headers["Authorization"]; got != "Bearer secret" {
+		t.Errorf("expected the header value to be sent, got %q", got)
+	}
+	if model.OrgID.ValueString() != "org-1" || model.SecretHash.IsNull() || model.SecretHash.IsUnknown() {
+		t.Errorf("expected org_id and secret_hash in state, got %+v", model)
+	}
+
+	// Read without changes keeps the configured header values
+	state, diags = h.read(state)
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("expected no drift, got %v", diags)
+	}
+	h.model(state, &model)
+	if !model.Webhook.Headers.Equal(headers) {
+		t.Errorf("expected configured headers to be kept, got %s", model.Webhook.Headers)
+	}
+	if !model.EventTypes.Equal(configured.EventTypes) || !model.CheckTags.Equal(configured.CheckTags) {
+		t.Errorf("expected filters to round-trip, got %s and %s", model.EventTypes, model.CheckTags)
+	}
+	if model.Webhook.BatchSize.ValueInt64() != 50 {
+		t.Errorf("expected batch_size 50, got %s", model.Webhook.BatchSize)
+	}
+
+	// A header value changed outside Terraform is detected through secret_hash
+	remote := standIn.sinks[model.ID.ValueString()]
+	remote.Webhook.Headers = map[string]string{"Authorization": "Bearer rotated"}
+	standIn.sinks[remote.ID] = remote
+	state, diags = h.read(state)
+	if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Export Sink Secret Drift Detected" {
+		t.Errorf("expected a drift warning, got %v", diags)
+	}
+	h.model(state, &model)
+	if model.Webhook.Headers.Equal(headers) {
+		t.Error("expected drifted headers to differ from the configuration so the next plan restores them")
+	}
+
+	// Update restores the header and clears the tag filter with an empty list
+	// rather than omitting it
+	updated := configured
+	updated.ID = model.ID
+	updated.Enabled = types.BoolValue(false)
+	updated.CheckTags = types.SetNull(types.StringType)
+	state, diags = h.update(h.plan(updated), state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics after update: %v", diags)
+	}
+	if tags := standIn.lastUpdate.CheckTags; tags == nil || len(*tags) != 0 {
+		t.Errorf("expected check_tags to be cleared with [], got %v", tags)
+	}
+	if got := standIn.sinks[model.ID.ValueString()].Webhook.Headers["Authorization"]; got != "Bearer secret" {
+		t.Errorf("expected the configured header to be sent again, got %q", got)
+	}
+
+	state, diags = h.read(state)
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("expected no drift after update, got %v", diags)
+	}
+	h.model(state, &model)
+	if !model.CheckTags.IsNull() || model.Enabled.ValueBool() || !model.Webhook.Headers.Equal(headers) {
+		t.Errorf("expected no tag filter, a disabled sink and the configured headers, got %+v", model)
+	}
+
+	// After a downgrade the unchanged sink still plans, but changes are rejected
+	c.Organization = testTierOrganization()
+	if _, diags := h.modifyPlan(h.plan(model), state); diags.HasError() {
+		t.Errorf("expected no error for an unchanged sink, got %v", diags)
+	}
+	renamed := model
+	renamed.Name = types.StringValue("renamed")
+	if _, diags := h.modifyPlan(h.plan(renamed), state); !diags.HasError() {
+		t.Error("expected a tier error for a changed sink")
+	}
+
+	// Delete
+	if diags := h.delete(state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics after delete: %v", diags)
+	}
+	if len(standIn.sinks) != 0 {
+		t.Errorf("expected the sink to be deleted, got %v", standIn.sinks)
+	}
+}
+
+func TestFlattenExportSink_KeepsSecrets(t *testing.T) {
+	ctx := context.Background()
+	model := exportSinkResourceModel{
+		S3: &exportSinkS3Model{SecretAccessKey: types.StringValue("minio-secret")},
+	}
+	sink := &client.ExportSink{
+		ID:         "sink-1",
+		Type:       "s3",
+		EventTypes: []string{client.ExportEventCheckResult},
+		S3: &client.ExportSinkS3{
+			Bucket:         "events",
+			Endpoint:       "http://127.0.0.1:9000",
+			AccessKeyID:    "minio",
+			ForcePathStyle: true,
+		},
+	}
+
+	var diags diag.Diagnostics
+	flattenExportSink(ctx, sink, &model, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if model.S3.SecretAccessKey.ValueString() != "minio-secret" {
+		t.Errorf("expected the secret key to be kept, got %s", model.S3.SecretAccessKey)
+	}
+	if !model.S3.Region.IsNull() || model.S3.Endpoint.ValueString() != "http://127.0.0.1:9000" {
+		t.Errorf("unexpected s3 state %+v", model.S3)
+	}
+
+	// A key rotated outside Terraform is forgotten so the next plan sets it again
+	model.SecretHash = types.StringValue("a1b2c3")
+	sink.SecretHash = "d4e5f6"
+	flattenExportSink(ctx, sink, &model, &diags)
+	if diags.WarningsCount() != 1 {
+		t.Errorf("expected a drift warning, got %v", diags)
+	}
+	if !model.S3.SecretAccessKey.IsNull() || model.SecretHash.ValueString() != "d4e5f6" {
+		t.Errorf("expected a null secret key and the new hash, got %s and %s", model.S3.SecretAccessKey, model.SecretHash)
+	}
+
+	// After import there is nothing to keep
+	model = exportSinkResourceModel{}
+	flattenExportSink(ctx, sink, &model, &diags)
+	if !model.S3.SecretAccessKey.IsNull() {
+		t.Errorf("expected a null secret key after import, got %s", model.S3.SecretAccessKey)
+	}
+}
+
+func TestAccExportSinkResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "quismon_export_sink" "test" {
+  name        = "tf-acc-events"
+  type        = "webhook"
+  event_types = ["alert_transition"]
+  check_tags  = ["production"]
+
+  webhook = {
+    url = "https://events.example.com/ingest"
+
+    headers = {
+      Authorization = "Bearer test"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quismon_export_sink.test", "id"),
+					resource.TestCheckResourceAttr("quismon_export_sink.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("quismon_export_sink.test", "event_types.#", "1"),
+					resource.TestCheckResourceAttr("quismon_export_sink.test", "webhook.batch_size", "100"),
+				),
+			},
+			{
+				ResourceName:            "quismon_export_sink.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook.headers", "updated_at"},
+			},
+			{
+				Config: `
+resource "quismon_export_sink" "test" {
+  name = "tf-acc-events"
+  type = "s3"
+
+  webhook = {
+    url = "https://events.example.com/ingest"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing Export Sink Destination`),
+			},
+		},
+	})
+}
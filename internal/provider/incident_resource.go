@@ -0,0 +1,545 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// incidentStatuses lists the statuses valid for each incident kind.
+var incidentStatuses = map[string][]string{
+	"announced": {"investigating", "identified", "monitoring", "resolved"},
+	"scheduled": {"scheduled", "in_progress", "completed"},
+}
+
+// incidentImpacts lists the supported incident impact levels.
+var incidentImpacts = []string{"none", "minor", "major", "critical"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &incidentResource{}
+	_ resource.ResourceWithConfigure      = &incidentResource{}
+	_ resource.ResourceWithImportState    = &incidentResource{}
+	_ resource.ResourceWithValidateConfig = &incidentResource{}
+	_ resource.ResourceWithModifyPlan     = &incidentResource{}
+)
+
+// NewIncidentResource is a helper function to simplify the provider implementation.
+func NewIncidentResource() resource.Resource {
+	return &incidentResource{}
+}
+
+// incidentResource is the resource implementation.
+type incidentResource struct {
+	client *client.Client
+}
+
+// incidentResourceModel maps the resource schema data.
+type incidentResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	OrgID                types.String `tfsdk:"org_id"`
+	StatusPageID         types.String `tfsdk:"status_page_id"`
+	Title                types.String `tfsdk:"title"`
+	Kind                 types.String `tfsdk:"kind"`
+	Status               types.String `tfsdk:"status"`
+	Impact               types.String `tfsdk:"impact"`
+	AffectedComponentIDs types.Set    `tfsdk:"affected_component_ids"`
+	ScheduledStart       types.String `tfsdk:"scheduled_start"`
+	ScheduledEnd         types.String `tfsdk:"scheduled_end"`
+	Updates              types.List   `tfsdk:"updates"`
+	StartedAt            types.String `tfsdk:"started_at"`
+	ResolvedAt           types.String `tfsdk:"resolved_at"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *incidentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident"
+}
+
+// Schema defines the schema for the resource.
+func (r *incidentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an incident on a Quismon status page, either announced (an ongoing problem) or scheduled (planned maintenance).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Incident ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "Organization ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page_id": schema.StringAttribute{
+				Description: "ID of the status page the incident is shown on. Changing this will force recreation of the incident.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Public title of the incident.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description: "Incident kind: announced or scheduled. Default is announced. Changing this will force recreation of the incident.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("announced"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("announced", "scheduled"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Current status. Announced incidents: investigating, identified, monitoring, or resolved. " +
+					"Scheduled incidents: scheduled, in_progress, or completed.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(append(append([]string{}, incidentStatuses["announced"]...), incidentStatuses["scheduled"]...)...),
+				},
+			},
+			"impact": schema.StringAttribute{
+				Description: "Impact level: none, minor, major, or critical. Default is minor.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("minor"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(incidentImpacts...),
+				},
+			},
+			"affected_component_ids": schema.SetAttribute{
+				Description: "IDs of the quismon_status_page_component resources affected by the incident.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"scheduled_start": schema.StringAttribute{
+				Description: "Start of the maintenance window as an RFC 3339 timestamp. Required for scheduled incidents.",
+				Optional:    true,
+				Validators: []validator.String{
+					RFC3339Timestamp(),
+				},
+			},
+			"scheduled_end": schema.StringAttribute{
+				Description: "End of the maintenance window as an RFC 3339 timestamp. Required for scheduled incidents.",
+				Optional:    true,
+				Validators: []validator.String{
+					RFC3339Timestamp(),
+				},
+			},
+			"updates": schema.ListAttribute{
+				Description: "Progress messages posted on the incident, oldest first. Updates are append-only: add new messages to the end of the list. " +
+					"Each new message is posted with the incident's status at the time it is applied. Updates posted outside Terraform are not tracked.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				Description: "When the incident started.",
+				Computed:    true,
+			},
+			"resolved_at": schema.StringAttribute{
+				Description: "When the incident was resolved or the maintenance completed. Null while the incident is open.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the status and schedule match the incident kind.
+func (r *incidentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config incidentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Kind.IsUnknown() {
+		return
+	}
+	kind := "announced"
+	if !config.Kind.IsNull() {
+		kind = config.Kind.ValueString()
+	}
+
+	if !config.Status.IsNull() && !config.Status.IsUnknown() {
+		valid := false
+		for _, s := range incidentStatuses[kind] {
+			if s == config.Status.ValueString() {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("status"),
+				"Invalid Incident Status",
+				fmt.Sprintf("Status %q is not valid for %s incidents. Valid statuses: %s.",
+					config.Status.ValueString(), kind, strings.Join(incidentStatuses[kind], ", ")),
+			)
+		}
+	}
+
+	for _, attr := range []struct {
+		name  string
+		value types.String
+	}{
+		{"scheduled_start", config.ScheduledStart},
+		{"scheduled_end", config.ScheduledEnd},
+	} {
+		if kind == "scheduled" && attr.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				"Missing Maintenance Window",
+				attr.name+" must be set for scheduled incidents.",
+			)
+		}
+		if kind == "announced" && !attr.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				"Unexpected Maintenance Window",
+				attr.name+" can only be set for scheduled incidents.",
+			)
+		}
+	}
+}
+
+// ModifyPlan rejects plans that edit or remove updates that have already been posted.
+func (r *incidentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state incidentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Updates.IsUnknown() {
+		return
+	}
+
+	posted := state.Updates.Elements()
+	planned := plan.Updates.Elements()
+	if len(planned) < len(posted) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("updates"),
+			"Incident Updates Are Append-Only",
+			fmt.Sprintf("%d updates have already been posted and cannot be removed. Add new messages to the end of the list instead.", len(posted)),
+		)
+		return
+	}
+	for i := range posted {
+		if !planned[i].IsUnknown() && !planned[i].Equal(posted[i]) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("updates").AtListIndex(i),
+				"Incident Updates Are Append-Only",
+				"This update has already been posted and cannot be changed. Add a new message to the end of the list instead.",
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *incidentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the incident, posts its updates and sets the initial Terraform state.
+func (r *incidentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan incidentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	componentIDs := []string{}
+	if !plan.AffectedComponentIDs.IsNull() {
+		diags = plan.AffectedComponentIDs.ElementsAs(ctx, &componentIDs, false)
+		resp.Diagnostics.Append(diags...)
+	}
+	var updates []string
+	if !plan.Updates.IsNull() {
+		diags = plan.Updates.ElementsAs(ctx, &updates, false)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	incident, err := r.client.CreateIncident(client.CreateIncidentRequest{
+		StatusPageID:         plan.StatusPageID.ValueString(),
+		Title:                plan.Title.ValueString(),
+		Kind:                 plan.Kind.ValueString(),
+		Status:               plan.Status.ValueString(),
+		Impact:               plan.Impact.ValueString(),
+		AffectedComponentIDs: componentIDs,
+		ScheduledStart:       plan.ScheduledStart.ValueStringPointer(),
+		ScheduledEnd:         plan.ScheduledEnd.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Incident",
+			"Could not create incident, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(incident.ID)
+	mapIncidentComputed(incident, &plan)
+
+	// Save the incident before posting updates so a failed update does not orphan it.
+	postedUpdates := plan.Updates
+	plan.Updates = types.ListNull(types.StringType)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if n, err := r.postUpdates(incident.ID, plan.Status.ValueString(), updates); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Posting Incident Update",
+			"Incident was created but an update could not be posted, unexpected error: "+err.Error(),
+		)
+		// Record the updates that were posted so the next apply does not repost them
+		plan.Updates, diags = incidentUpdatesValue(ctx, updates[:n])
+		resp.Diagnostics.Append(diags...)
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	plan.Updates = postedUpdates
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *incidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state incidentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	incident, err := r.client.GetIncident(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Incident",
+			"Could not read incident ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.StatusPageID = types.StringValue(incident.StatusPageID)
+	state.Title = types.StringValue(incident.Title)
+	state.Kind = types.StringValue(incident.Kind)
+	state.Status = types.StringValue(incident.Status)
+	state.Impact = types.StringValue(incident.Impact)
+	if len(incident.AffectedComponentIDs) > 0 {
+		componentIDs, diags := types.SetValueFrom(ctx, types.StringType, incident.AffectedComponentIDs)
+		resp.Diagnostics.Append(diags...)
+		state.AffectedComponentIDs = componentIDs
+	} else {
+		state.AffectedComponentIDs = types.SetNull(types.StringType)
+	}
+	// Only populate the window when unset (e.g. on import); the API may normalize
+	// the timestamp format, which would otherwise show as a diff.
+	if state.ScheduledStart.IsNull() && incident.ScheduledStart != nil {
+		state.ScheduledStart = types.StringValue(*incident.ScheduledStart)
+	}
+	if state.ScheduledEnd.IsNull() && incident.ScheduledEnd != nil {
+		state.ScheduledEnd = types.StringValue(*incident.ScheduledEnd)
+	}
+	// updates only records the messages Terraform posted, so updates posted from
+	// the dashboard are ignored. On import nothing has been recorded yet and the
+	// existing updates are adopted.
+	if state.CreatedAt.IsNull() {
+		messages := make([]string, 0, len(incident.Updates))
+		for _, update := range incident.Updates {
+			messages = append(messages, update.Message)
+		}
+		state.Updates, diags = incidentUpdatesValue(ctx, messages)
+		resp.Diagnostics.Append(diags...)
+	}
+	mapIncidentComputed(incident, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the incident, posts any new updates and sets the updated Terraform state on success.
+func (r *incidentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state incidentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	componentIDs := []string{}
+	if !plan.AffectedComponentIDs.IsNull() {
+		diags = plan.AffectedComponentIDs.ElementsAs(ctx, &componentIDs, false)
+		resp.Diagnostics.Append(diags...)
+	}
+	var updates []string
+	if !plan.Updates.IsNull() {
+		diags = plan.Updates.ElementsAs(ctx, &updates, false)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	title := plan.Title.ValueString()
+	status := plan.Status.ValueString()
+	impact := plan.Impact.ValueString()
+	incident, err := r.client.UpdateIncident(plan.ID.ValueString(), client.UpdateIncidentRequest{
+		Title:                &title,
+		Status:               &status,
+		Impact:               &impact,
+		AffectedComponentIDs: &componentIDs,
+		ScheduledStart:       plan.ScheduledStart.ValueStringPointer(),
+		ScheduledEnd:         plan.ScheduledEnd.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Incident",
+			"Could not update incident, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapIncidentComputed(incident, &plan)
+
+	// ModifyPlan guarantees the already-posted updates are a prefix of the planned list.
+	posted := len(state.Updates.Elements())
+	if posted < len(updates) {
+		if n, err := r.postUpdates(plan.ID.ValueString(), status, updates[posted:]); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Posting Incident Update",
+				"Could not post incident update, unexpected error: "+err.Error(),
+			)
+			// The incident itself was updated: save it with the updates that were
+			// posted so the next apply only posts the rest.
+			plan.Updates, diags = incidentUpdatesValue(ctx, updates[:posted+n])
+			resp.Diagnostics.Append(diags...)
+			diags = resp.State.Set(ctx, plan)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *incidentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state incidentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteIncident(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Incident",
+			"Could not delete incident, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *incidentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// postUpdates posts each message as an incident update with the given status,
+// stopping at the first failure. It returns how many messages were posted.
+func (r *incidentResource) postUpdates(incidentID, status string, messages []string) (int, error) {
+	for i, message := range messages {
+		if _, err := r.client.AddIncidentUpdate(incidentID, client.CreateIncidentUpdateRequest{
+			Status:  status,
+			Message: message,
+		}); err != nil {
+			return i, err
+		}
+	}
+	return len(messages), nil
+}
+
+// incidentUpdatesValue converts posted update messages to the updates list,
+// which Read maps to null when there are none.
+func incidentUpdatesValue(ctx context.Context, messages []string) (types.List, diag.Diagnostics) {
+	if len(messages) == 0 {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, messages)
+}
+
+// mapIncidentComputed copies the computed fields of an API response into the model.
+func mapIncidentComputed(incident *client.Incident, model *incidentResourceModel) {
+	model.OrgID = types.StringValue(incident.OrgID)
+	model.StartedAt = types.StringPointerValue(incident.StartedAt)
+	model.ResolvedAt = types.StringPointerValue(incident.ResolvedAt)
+	model.CreatedAt = types.StringValue(incident.CreatedAt)
+	model.UpdatedAt = types.StringValue(incident.UpdatedAt)
+}
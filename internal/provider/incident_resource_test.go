@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// incidentStandIn serves /v1/incidents from memory.
+type incidentStandIn struct {
+	incidents map[string]client.Incident
+}
+
+func (s *incidentStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, updates := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/v1/incidents/"), "/updates")
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/incidents":
+		var req client.CreateIncidentRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		incident := client.Incident{
+			ID:                   fmt.Sprintf("incident-%d", len(s.incidents)+1),
+			OrgID:                "org-1",
+			StatusPageID:         req.StatusPageID,
+			Title:                req.Title,
+			Kind:                 req.Kind,
+			Status:               req.Status,
+			Impact:               req.Impact,
+			AffectedComponentIDs: req.AffectedComponentIDs,
+			CreatedAt:            "2026-01-01T00:00:00Z",
+			UpdatedAt:            "2026-01-01T00:00:00Z",
+		}
+		s.incidents[incident.ID] = incident
+		respondStandIn(w, incident)
+
+	case r.Method == http.MethodPost && updates && s.incidents[id].ID != "":
+		var req client.CreateIncidentUpdateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		respondStandIn(w, s.post(id, req.Status, req.Message))
+
+	case r.Method == http.MethodPut && s.incidents[id].ID != "":
+		var req client.UpdateIncidentRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		incident := s.incidents[id]
+		incident.Title, incident.Status, incident.Impact = *req.Title, *req.Status, *req.Impact
+		incident.AffectedComponentIDs = *req.AffectedComponentIDs
+		incident.UpdatedAt = "2026-01-02T00:00:00Z"
+		s.incidents[id] = incident
+		respondStandIn(w, incident)
+
+	case r.Method == http.MethodGet && s.incidents[id].ID != "":
+		respondStandIn(w, s.incidents[id])
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// post adds an update to an incident, as both the provider and the dashboard do.
+func (s *incidentStandIn) post(id, status, message string) client.IncidentUpdate {
+	incident := s.incidents[id]
+	update := client.IncidentUpdate{
+		ID:        fmt.Sprintf("update-%d", len(incident.Updates)+1),
+		Status:    status,
+		Message:   message,
+		CreatedAt: "2026-01-01T00:00:00Z",
+	}
+	incident.Updates = append(incident.Updates, update)
+	s.incidents[id] = incident
+	return update
+}
+
+// respondStandIn writes v wrapped in the API's data envelope.
+func respondStandIn(w http.ResponseWriter, v interface{}) {
+	data, _ := json.Marshal(v)
+	fmt.Fprintf(w, `{"data":%s}`, data)
+}
+
+func TestIncidentResource_DashboardUpdatesIgnored(t *testing.T) {
+	standIn := &incidentStandIn{incidents: map[string]client.Incident{}}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	c, err := client.New(server.URL, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newResourceHarness(t, NewIncidentResource(), c)
+
+	updates := func(messages ...string) types.List {
+		values := make([]attr.Value, 0, len(messages))
+		for _, message := range messages {
+			values = append(values, types.StringValue(message))
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+	configured := incidentResourceModel{
+		ID:                   types.StringUnknown(),
+		OrgID:                types.StringUnknown(),
+		StatusPageID:         types.StringValue("page-1"),
+		Title:                types.StringValue("Elevated error rates"),
+		Kind:                 types.StringValue("announced"),
+		Status:               types.StringValue("investigating"),
+		Impact:               types.StringValue("major"),
+		AffectedComponentIDs: types.SetNull(types.StringType),
+		ScheduledStart:       types.StringNull(),
+		ScheduledEnd:         types.StringNull(),
+		Updates:              updates("We are investigating."),
+		StartedAt:            types.StringUnknown(),
+		ResolvedAt:           types.StringUnknown(),
+		CreatedAt:            types.StringUnknown(),
+		UpdatedAt:            types.StringUnknown(),
+	}
+
+	state, diags := h.create(h.plan(configured))
+	if diags.HasError() {
+		t.Fatalf("create: %v", diags)
+	}
+	var created incidentResourceModel
+	h.model(state, &created)
+
+	// An update posted from the dashboard is not adopted into state.
+	standIn.post(created.ID.ValueString(), "identified", "Posted from the dashboard.")
+	state, diags = h.read(state)
+	if diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+	var refreshed incidentResourceModel
+	h.model(state, &refreshed)
+	if !refreshed.Updates.Equal(created.Updates) {
+		t.Fatalf("updates after read = %v, want %v", refreshed.Updates, created.Updates)
+	}
+
+	// The unchanged configuration still plans cleanly, and a new message is
+	// appended after the ones Terraform posted.
+	configured.ID, configured.OrgID = created.ID, created.OrgID
+	configured.CreatedAt = created.CreatedAt
+	if _, diags := h.modifyPlan(h.plan(configured), state); diags.HasError() {
+		t.Fatalf("modify plan: %v", diags)
+	}
+	configured.Updates = updates("We are investigating.", "A fix has been deployed.")
+	plan, diags := h.modifyPlan(h.plan(configured), state)
+	if diags.HasError() {
+		t.Fatalf("modify plan: %v", diags)
+	}
+	if _, diags := h.update(plan, state); diags.HasError() {
+		t.Fatalf("update: %v", diags)
+	}
+
+	var messages []string
+	for _, update := range standIn.incidents[created.ID.ValueString()].Updates {
+		messages = append(messages, update.Message)
+	}
+	want := []string{"We are investigating.", "Posted from the dashboard.", "A fix has been deployed."}
+	if strings.Join(messages, "|") != strings.Join(want, "|") {
+		t.Errorf("posted updates = %q, want %q", messages, want)
+	}
+}
+
+func TestAccIncidentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIncidentConfig("investigating", `["We are investigating elevated error rates."]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_incident.test", "kind", "announced"),
+					resource.TestCheckResourceAttr("quismon_incident.test", "status", "investigating"),
+					resource.TestCheckResourceAttr("quismon_incident.test", "impact", "major"),
+					resource.TestCheckResourceAttr("quismon_incident.test", "updates.#", "1"),
+					resource.TestCheckResourceAttrSet("quismon_incident.test", "started_at"),
+					resource.TestCheckNoResourceAttr("quismon_incident.test", "resolved_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quismon_incident.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Resolve with a new update
+			{
+				Config: testAccIncidentConfig("resolved", `["We are investigating elevated error rates.", "A fix has been deployed."]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_incident.test", "status", "resolved"),
+					resource.TestCheckResourceAttr("quismon_incident.test", "updates.#", "2"),
+					resource.TestCheckResourceAttrSet("quismon_incident.test", "resolved_at"),
+				),
+			},
+			// Posted updates cannot be edited
+			{
+				Config:      testAccIncidentConfig("resolved", `["Edited message.", "A fix has been deployed."]`),
+				ExpectError: regexp.MustCompile(`Incident Updates Are Append-Only`),
+			},
+		},
+	})
+}
+
+func TestAccIncidentResource_Scheduled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "quismon_incident" "test" {
+  status_page_id = "00000000-0000-0000-0000-000000000000"
+  title          = "Database maintenance"
+  kind           = "scheduled"
+  status         = "investigating"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Incident Status`),
+			},
+		},
+	})
+}
+
+func testAccIncidentConfig(status, updates string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "test" {
+  name             = "tf-acc-incident-check"
+  type             = "https"
+  interval_seconds = 300
+  regions          = ["na-east-ewr"]
+  config = {
+    url = "https://api.example.com/health"
+  }
+}
+
+resource "quismon_status_page" "test" {
+  slug     = "tf-acc-incidents"
+  title    = "Incident Status"
+  grouping = "components"
+}
+
+resource "quismon_status_page_component" "api" {
+  status_page_id = quismon_status_page.test.id
+  name           = "API"
+  check_ids      = [quismon_check.test.id]
+}
+
+resource "quismon_incident" "test" {
+  status_page_id         = quismon_status_page.test.id
+  title                  = "Elevated API error rates"
+  status                 = %[1]q
+  impact                 = "major"
+  affected_component_ids = [quismon_status_page_component.api.id]
+  updates                = %[2]s
+}
+`, status, updates)
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+var _ datasource.DataSource = &incidentsDataSource{}
+
+func NewIncidentsDataSource() datasource.DataSource {
+	return &incidentsDataSource{}
+}
+
+type incidentsDataSource struct {
+	client *client.Client
+}
+
+// incidentsDataSourceModel maps the data source schema data
+type incidentsDataSourceModel struct {
+	StatusPageID types.String             `tfsdk:"status_page_id"`
+	State        types.String             `tfsdk:"state"`
+	Since        types.String             `tfsdk:"since"`
+	OpenCount    types.Int64              `tfsdk:"open_count"`
+	Incidents    []incidentDataSourceItem `tfsdk:"incidents"`
+}
+
+// incidentDataSourceItem maps a single incident in the list
+type incidentDataSourceItem struct {
+	ID                   types.String `tfsdk:"id"`
+	StatusPageID         types.String `tfsdk:"status_page_id"`
+	Title                types.String `tfsdk:"title"`
+	Kind                 types.String `tfsdk:"kind"`
+	Status               types.String `tfsdk:"status"`
+	Impact               types.String `tfsdk:"impact"`
+	Open                 types.Bool   `tfsdk:"open"`
+	AffectedComponentIDs types.Set    `tfsdk:"affected_component_ids"`
+	ScheduledStart       types.String `tfsdk:"scheduled_start"`
+	ScheduledEnd         types.String `tfsdk:"scheduled_end"`
+	StartedAt            types.String `tfsdk:"started_at"`
+	ResolvedAt           types.String `tfsdk:"resolved_at"`
+	LatestUpdate         types.String `tfsdk:"latest_update"`
+}
+
+func (d *incidentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incidents"
+}
+
+func (d *incidentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches open and recent Quismon incidents, newest first. Useful for change-freeze gates and postmortem automation.",
+		Attributes: map[string]schema.Attribute{
+			"status_page_id": schema.StringAttribute{
+				Description: "Only return incidents on this status page.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Only return open (ongoing or upcoming) or closed (resolved or completed) incidents. Returns both when unset.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("open", "closed"),
+				},
+			},
+			"since": schema.StringAttribute{
+				Description: "Only return incidents started or scheduled after this RFC 3339 timestamp.",
+				Optional:    true,
+				Validators: []validator.String{
+					RFC3339Timestamp(),
+				},
+			},
+			"open_count": schema.Int64Attribute{
+				Description: "Number of open incidents among the results.",
+				Computed:    true,
+			},
+			"incidents": schema.ListNestedAttribute{
+				Description: "List of incidents.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"status_page_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"title": schema.StringAttribute{
+							Computed: true,
+						},
+						"kind": schema.StringAttribute{
+							Description: "announced or scheduled.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+						"impact": schema.StringAttribute{
+							Computed: true,
+						},
+						"open": schema.BoolAttribute{
+							Description: "Whether the incident is ongoing or upcoming.",
+							Computed:    true,
+						},
+						"affected_component_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"scheduled_start": schema.StringAttribute{
+							Computed: true,
+						},
+						"scheduled_end": schema.StringAttribute{
+							Computed: true,
+						},
+						"started_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"resolved_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"latest_update": schema.StringAttribute{
+							Description: "Message of the most recent update, if any.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *incidentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *incidentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data incidentsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	incidents, err := d.client.ListIncidents(client.ListIncidentsOptions{
+		StatusPageID: data.StatusPageID.ValueString(),
+		State:        data.State.ValueString(),
+		Since:        data.Since.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Incidents", err.Error())
+		return
+	}
+
+	openCount := 0
+	data.Incidents = []incidentDataSourceItem{}
+	for _, incident := range incidents {
+		componentIDs, diags := types.SetValueFrom(ctx, types.StringType, incident.AffectedComponentIDs)
+		resp.Diagnostics.Append(diags...)
+
+		latestUpdate := types.StringNull()
+		if len(incident.Updates) > 0 {
+			latestUpdate = types.StringValue(incident.Updates[len(incident.Updates)-1].Message)
+		}
+		if incident.IsOpen() {
+			openCount++
+		}
+
+		data.Incidents = append(data.Incidents, incidentDataSourceItem{
+			ID:                   types.StringValue(incident.ID),
+			StatusPageID:         types.StringValue(incident.StatusPageID),
+			Title:                types.StringValue(incident.Title),
+			Kind:                 types.StringValue(incident.Kind),
+			Status:               types.StringValue(incident.Status),
+			Impact:               types.StringValue(incident.Impact),
+			Open:                 types.BoolValue(incident.IsOpen()),
+			AffectedComponentIDs: componentIDs,
+			ScheduledStart:       types.StringPointerValue(incident.ScheduledStart),
+			ScheduledEnd:         types.StringPointerValue(incident.ScheduledEnd),
+			StartedAt:            types.StringPointerValue(incident.StartedAt),
+			ResolvedAt:           types.StringPointerValue(incident.ResolvedAt),
+			LatestUpdate:         latestUpdate,
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.OpenCount = types.Int64Value(int64(openCount))
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ipOrCIDRValidator validates that a string attribute holds a single IP address
+// (e.g. 203.0.113.10) or a CIDR block (e.g. 10.0.0.0/8).
+type ipOrCIDRValidator struct{}
+
+// Description returns a human-readable description of the validator.
+func (v ipOrCIDRValidator) Description(_ context.Context) string {
+	return "value must be an IP address or CIDR block"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v ipOrCIDRValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be an IP address (e.g. `203.0.113.10`) or CIDR block (e.g. `10.0.0.0/8`)"
+}
+
+// ValidateString implements the validator interface.
+func (v ipOrCIDRValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) != nil {
+		return
+	}
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid IP Address or CIDR Block",
+		"Expected an IP address (e.g. 203.0.113.10) or CIDR block (e.g. 10.0.0.0/8), got: "+value,
+	)
+}
+
+// IPOrCIDR returns a validator which ensures a string is an IP address or CIDR block.
+func IPOrCIDR() validator.String {
+	return ipOrCIDRValidator{}
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+var _ datasource.DataSource = &organizationDataSource{}
+
+func NewOrganizationDataSource() datasource.DataSource {
+	return &organizationDataSource{}
+}
+
+type organizationDataSource struct {
+	client *client.Client
+}
+
+// organizationDataSourceModel maps the data source schema data
+type organizationDataSourceModel struct {
+	ID                   types.String             `tfsdk:"id"`
+	Name                 types.String             `tfsdk:"name"`
+	Tier                 types.String             `tfsdk:"tier"`
+	EmailVerified        types.Bool               `tfsdk:"email_verified"`
+	VerificationRequired types.Bool               `tfsdk:"verification_required"`
+	DefaultTimeZone      types.String             `tfsdk:"default_time_zone"`
+	DefaultRegions       types.Set                `tfsdk:"default_regions"`
+	BillingEmail         types.String             `tfsdk:"billing_email"`
+	Limits               *organizationLimitsModel `tfsdk:"limits"`
+	CheckCount           types.Int64              `tfsdk:"check_count"`
+	CheckQuotaRemaining  types.Int64              `tfsdk:"check_quota_remaining"`
+}
+
+// organizationLimitsModel maps the nested limits object
+type organizationLimitsModel struct {
+	MaxChecks          types.Int64 `tfsdk:"max_checks"`
+	MinIntervalSeconds types.Int64 `tfsdk:"min_interval_seconds"`
+	MaxRegionsPerCheck types.Int64 `tfsdk:"max_regions_per_check"`
+	ChecksPerHour      types.Int64 `tfsdk:"checks_per_hour"`
+	Features           types.Set   `tfsdk:"features"`
+	AllowedCheckTypes  types.Set   `tfsdk:"allowed_check_types"`
+}
+
+func (d *organizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (d *organizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the organization the provider's API key belongs to, including tier limits and quota usage.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Organization ID.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Organization name.",
+				Computed:    true,
+			},
+			"tier": schema.StringAttribute{
+				Description: "Subscription tier (e.g. free, paid, enterprise).",
+				Computed:    true,
+			},
+			"email_verified": schema.BoolAttribute{
+				Description: "Whether the organization's email address has been verified.",
+				Computed:    true,
+			},
+			"verification_required": schema.BoolAttribute{
+				Description: "Whether email verification is still required before checks can run.",
+				Computed:    true,
+			},
+			"default_time_zone": schema.StringAttribute{
+				Description: "Default IANA time zone for the organization.",
+				Computed:    true,
+			},
+			"default_regions": schema.SetAttribute{
+				Description: "Default monitoring regions for new checks.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"billing_email": schema.StringAttribute{
+				Description: "Billing contact email address.",
+				Computed:    true,
+			},
+			"limits": schema.SingleNestedAttribute{
+				Description: "Limits of the organization's tier. A value of 0 means unlimited.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"max_checks": schema.Int64Attribute{
+						Description: "Maximum number of checks.",
+						Computed:    true,
+					},
+					"min_interval_seconds": schema.Int64Attribute{
+						Description: "Shortest allowed check interval in seconds.",
+						Computed:    true,
+					},
+					"max_regions_per_check": schema.Int64Attribute{
+						Description: "Maximum number of regions per check.",
+						Computed:    true,
+					},
+					"checks_per_hour": schema.Int64Attribute{
+						Description: "Maximum check executions per hour across the organization.",
+						Computed:    true,
+					},
+					"features": schema.SetAttribute{
+						Description: "Paid features available to the tier (e.g. otlp).",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"allowed_check_types": schema.SetAttribute{
+						Description: "Check types available to the tier. Empty means all check types are allowed.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"check_count": schema.Int64Attribute{
+				Description: "Number of checks currently in the organization.",
+				Computed:    true,
+			},
+			"check_quota_remaining": schema.Int64Attribute{
+				Description: "Number of checks that can still be created. Null if the tier has no check limit.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *organizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *organizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	org, err := d.client.GetOrganization()
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Organization", err.Error())
+		return
+	}
+
+	defaultRegions, diags := types.SetValueFrom(ctx, types.StringType, org.DefaultRegions)
+	resp.Diagnostics.Append(diags...)
+	features, diags := types.SetValueFrom(ctx, types.StringType, org.Limits.Features)
+	resp.Diagnostics.Append(diags...)
+	allowedCheckTypes, diags := types.SetValueFrom(ctx, types.StringType, org.Limits.AllowedCheckTypes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := organizationDataSourceModel{
+		ID:                   types.StringValue(org.ID),
+		Name:                 types.StringValue(org.Name),
+		Tier:                 types.StringValue(org.Tier),
+		EmailVerified:        types.BoolValue(org.EmailVerified),
+		VerificationRequired: types.BoolValue(org.VerificationRequired),
+		DefaultTimeZone:      types.StringValue(org.DefaultTimeZone),
+		DefaultRegions:       defaultRegions,
+		BillingEmail:         types.StringValue(org.BillingEmail),
+		Limits: &organizationLimitsModel{
+			MaxChecks:          types.Int64Value(int64(org.Limits.MaxChecks)),
+			MinIntervalSeconds: types.Int64Value(int64(org.Limits.MinIntervalSeconds)),
+			MaxRegionsPerCheck: types.Int64Value(int64(org.Limits.MaxRegionsPerCheck)),
+			ChecksPerHour:      types.Int64Value(int64(org.Limits.ChecksPerHour)),
+			Features:           features,
+			AllowedCheckTypes:  allowedCheckTypes,
+		},
+		CheckCount:          types.Int64Value(int64(org.Usage.CheckCount)),
+		CheckQuotaRemaining: types.Int64Null(),
+	}
+
+	if org.Limits.MaxChecks > 0 {
+		remaining := org.Limits.MaxChecks - org.Usage.CheckCount
+		if remaining < 0 {
+			remaining = 0
+		}
+		data.CheckQuotaRemaining = types.Int64Value(int64(remaining))
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -2,22 +2,34 @@ package provider
 
 import (
 	"context"
+	"encoding/pem"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/quismon/terraform-provider-quismon/internal/client"
 )
 
+// otlpDefaultExportIntervalSeconds is the export interval the API uses when none is set.
+const otlpDefaultExportIntervalSeconds = 60
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &organizationOTLPResource{}
-	_ resource.ResourceWithConfigure   = &organizationOTLPResource{}
-	_ resource.ResourceWithImportState = &organizationOTLPResource{}
+	_ resource.Resource                   = &organizationOTLPResource{}
+	_ resource.ResourceWithConfigure      = &organizationOTLPResource{}
+	_ resource.ResourceWithImportState    = &organizationOTLPResource{}
+	_ resource.ResourceWithModifyPlan     = &organizationOTLPResource{}
+	_ resource.ResourceWithValidateConfig = &organizationOTLPResource{}
 )
 
 // NewOrganizationOTLPResource is a helper function to simplify the provider implementation.
@@ -32,10 +44,18 @@ type organizationOTLPResource struct {
 
 // organizationOTLPResourceModel maps the resource schema data.
 type organizationOTLPResourceModel struct {
-	Enabled                types.Bool   `tfsdk:"enabled"`
-	Endpoint               types.String `tfsdk:"endpoint"`
-	Headers                types.Map    `tfsdk:"headers"`
-	ExportIntervalSeconds  types.Int64  `tfsdk:"export_interval_seconds"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	Endpoint              types.String `tfsdk:"endpoint"`
+	Protocol              types.String `tfsdk:"protocol"`
+	Compression           types.String `tfsdk:"compression"`
+	Headers               types.Map    `tfsdk:"headers"`
+	HeadersHash           types.String `tfsdk:"headers_hash"`
+	ExportIntervalSeconds types.Int64  `tfsdk:"export_interval_seconds"`
+	ResourceAttributes    types.Map    `tfsdk:"resource_attributes"`
+	MetricFamilies        types.Set    `tfsdk:"metric_families"`
+	ClientCertificate     types.String `tfsdk:"client_certificate"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	CACertificate         types.String `tfsdk:"ca_certificate"`
 }
 
 // Metadata returns the resource type name.
@@ -54,24 +74,78 @@ func (r *organizationOTLPResource) Schema(_ context.Context, _ resource.SchemaRe
 				Required:    true,
 			},
 			"endpoint": schema.StringAttribute{
-				Description: "OTLP HTTP endpoint URL (e.g., https://otlp.example.com:4318/v1/metrics)",
-				Optional:    true,
+				Description: "OTLP endpoint. For http/protobuf a URL (e.g., https://otlp.example.com:4318/v1/metrics), " +
+					"for grpc host:port or https://host:port (e.g., otlp.example.com:4317)",
+				Optional: true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"protocol": schema.StringAttribute{
+				Description: "OTLP transport: http/protobuf or grpc (default http/protobuf)",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.OTLPProtocolHTTPProtobuf),
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.OTLPProtocolHTTPProtobuf, client.OTLPProtocolGRPC),
+				},
+			},
+			"compression": schema.StringAttribute{
+				Description: "Payload compression: none or gzip (default none)",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "gzip"),
+				},
+			},
 			"headers": schema.MapAttribute{
-				Description: "HTTP headers to include in OTLP requests (e.g., Authorization)",
+				Description: "Headers to include in OTLP requests (e.g., Authorization). Values cannot be read back from the API; " +
+					"changes made outside Terraform are detected via headers_hash and reverted on the next apply.",
 				Optional:    true,
+				Sensitive:   true,
 				ElementType: types.StringType,
 			},
+			"headers_hash": schema.StringAttribute{
+				Description: "Hash of the configured headers, used to detect changes made outside Terraform",
+				Computed:    true,
+			},
 			"export_interval_seconds": schema.Int64Attribute{
 				Description: "How often to export metrics in seconds (minimum 10, default 60)",
 				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(otlpDefaultExportIntervalSeconds),
 				Validators: []validator.Int64{
 					int64validator.AtLeast(10),
 				},
 			},
+			"resource_attributes": schema.MapAttribute{
+				Description: "Additional OpenTelemetry resource attributes attached to all exported metrics (e.g., deployment.environment)",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"metric_families": schema.SetAttribute{
+				Description: "Metric families to export: " + strings.Join(client.OTLPMetricFamilies, ", ") + ". All families are exported if not set",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(client.OTLPMetricFamilies...)),
+				},
+			},
+			"client_certificate": schema.StringAttribute{
+				Description: "PEM-encoded client certificate for mTLS. Requires client_key",
+				Optional:    true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM-encoded private key of the client certificate. Cannot be read back from the API",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				Description: "PEM-encoded CA certificate used to verify the collector, if it is not publicly trusted",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -94,48 +168,121 @@ func (r *organizationOTLPResource) Configure(_ context.Context, req resource.Con
 	r.client = client
 }
 
-// Create creates the resource and sets initial Terraform state.
-func (r *organizationOTLPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	// Retrieve values from plan
-	var plan organizationOTLPResourceModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+// ValidateConfig checks that an enabled export has an endpoint and that mTLS
+// settings are complete PEM blocks used over TLS.
+func (r *organizationOTLPResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config organizationOTLPResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Generate API request body from plan
-	updateReq := map[string]interface{}{
-		"enabled": plan.Enabled.ValueBool(),
+	if config.Enabled.ValueBool() && config.Endpoint.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Missing OTLP Endpoint",
+			"endpoint is required when enabled is true.",
+		)
 	}
 
-	if !plan.Endpoint.IsNull() {
-		updateReq["endpoint"] = plan.Endpoint.ValueString()
+	if config.ClientCertificate.IsNull() != config.ClientKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_certificate"),
+			"Incomplete mTLS Configuration",
+			"client_certificate and client_key must be set together.",
+		)
 	}
 
-	if !plan.Headers.IsNull() {
-		var headers map[string]string
-		diags := plan.Headers.ElementsAs(ctx, &headers, false)
-		resp.Diagnostics.Append(diags...)
+	pemValues := []struct {
+		name  string
+		value types.String
+	}{
+		{"client_certificate", config.ClientCertificate},
+		{"client_key", config.ClientKey},
+		{"ca_certificate", config.CACertificate},
+	}
+	for _, v := range pemValues {
+		if v.value.IsNull() || v.value.IsUnknown() {
+			continue
+		}
+		if block, _ := pem.Decode([]byte(v.value.ValueString())); block == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(v.name),
+				"Invalid PEM",
+				v.name+" must be PEM-encoded (-----BEGIN ...-----).",
+			)
+		}
+	}
+
+	usesTLSMaterial := !config.ClientCertificate.IsNull() || !config.CACertificate.IsNull()
+	if usesTLSMaterial && !config.Endpoint.IsUnknown() && strings.HasPrefix(config.Endpoint.ValueString(), "http://") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Insecure OTLP Endpoint",
+			"Certificates are only used over TLS; use an https:// endpoint.",
+		)
+	}
+}
+
+// ModifyPlan keeps headers_hash known while headers are unchanged, and fails the
+// plan early when the organization's tier does not include OTLP export.
+func (r *organizationOTLPResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var planHeaders, stateHeaders types.Map
+		var stateHash types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("headers"), &planHeaders)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("headers"), &stateHeaders)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("headers_hash"), &stateHash)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		// Convert to map[string]interface{}
-		headersInterface := make(map[string]interface{})
-		for k, v := range headers {
-			headersInterface[k] = v
+		if planHeaders.Equal(stateHeaders) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("headers_hash"), stateHash)...)
 		}
-		updateReq["headers"] = headersInterface
 	}
 
-	if !plan.ExportIntervalSeconds.IsNull() {
-		interval := int(plan.ExportIntervalSeconds.ValueInt64())
-		updateReq["export_interval_seconds"] = interval
+	if r.client == nil || r.client.Organization == nil {
+		return
+	}
+
+	// Don't fail plans for an unchanged export, e.g. after a downgrade
+	if !req.State.Raw.IsNull() && resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var enabled types.Bool
+	diags := req.Plan.GetAttribute(ctx, path.Root("enabled"), &enabled)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !enabled.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkPlanFeature(r.client.Organization, "otlp", "OTLP metrics export", path.Root("enabled"))...)
+}
+
+// Create creates the resource and sets initial Terraform state.
+func (r *organizationOTLPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan organizationOTLPResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updateReq, diags := expandOTLPConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Update OTLP config via API
-	_, err := r.client.DoRequest("PUT", "/v1/org/otlp", updateReq)
-	if err != nil {
+	if err := r.client.UpdateOTLPConfig(updateReq); err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating organization OTLP config",
 			"Could not create OTLP config, unexpected error: "+err.Error(),
@@ -144,7 +291,7 @@ func (r *organizationOTLPResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Get the current config to populate state
-	config, err := r.getOTLPConfig(ctx)
+	config, err := r.client.GetOTLPConfig()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading organization OTLP config",
@@ -154,12 +301,7 @@ func (r *organizationOTLPResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Map response to state
-	plan.Enabled = types.BoolValue(config.Enabled)
-	if config.Endpoint != nil {
-		plan.Endpoint = types.StringValue(*config.Endpoint)
-	} else {
-		plan.Endpoint = types.StringNull()
-	}
+	flattenOTLPConfig(ctx, &plan, config, &resp.Diagnostics)
 
 	// Set state
 	diags = resp.State.Set(ctx, plan)
@@ -180,7 +322,7 @@ func (r *organizationOTLPResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get refreshed OTLP config from API
-	config, err := r.getOTLPConfig(ctx)
+	config, err := r.client.GetOTLPConfig()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading organization OTLP config",
@@ -190,21 +332,7 @@ func (r *organizationOTLPResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Update state with refreshed values
-	state.Enabled = types.BoolValue(config.Enabled)
-	if config.Endpoint != nil {
-		state.Endpoint = types.StringValue(*config.Endpoint)
-	} else {
-		state.Endpoint = types.StringNull()
-	}
-
-	if config.ExportIntervalSeconds != nil {
-		state.ExportIntervalSeconds = types.Int64Value(int64(*config.ExportIntervalSeconds))
-	} else {
-		state.ExportIntervalSeconds = types.Int64Null()
-	}
-
-	// Note: Headers are not returned in full for security, so we keep the plan value
-	// unless it's been explicitly changed
+	flattenOTLPConfig(ctx, &state, config, &resp.Diagnostics)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -225,36 +353,14 @@ func (r *organizationOTLPResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Generate API request body from plan
-	updateReq := map[string]interface{}{
-		"enabled": plan.Enabled.ValueBool(),
-	}
-
-	if !plan.Endpoint.IsNull() {
-		updateReq["endpoint"] = plan.Endpoint.ValueString()
-	}
-
-	if !plan.Headers.IsNull() {
-		var headers map[string]string
-		diags := plan.Headers.ElementsAs(ctx, &headers, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		headersInterface := make(map[string]interface{})
-		for k, v := range headers {
-			headersInterface[k] = v
-		}
-		updateReq["headers"] = headersInterface
-	}
-
-	if !plan.ExportIntervalSeconds.IsNull() {
-		interval := int(plan.ExportIntervalSeconds.ValueInt64())
-		updateReq["export_interval_seconds"] = interval
+	updateReq, diags := expandOTLPConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Update OTLP config via API
-	_, err := r.client.DoRequest("PUT", "/v1/org/otlp", updateReq)
-	if err != nil {
+	if err := r.client.UpdateOTLPConfig(updateReq); err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating organization OTLP config",
 			"Could not update OTLP config, unexpected error: "+err.Error(),
@@ -263,7 +369,7 @@ func (r *organizationOTLPResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Get the current config to populate state
-	config, err := r.getOTLPConfig(ctx)
+	config, err := r.client.GetOTLPConfig()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading organization OTLP config",
@@ -273,18 +379,7 @@ func (r *organizationOTLPResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Map response to state
-	plan.Enabled = types.BoolValue(config.Enabled)
-	if config.Endpoint != nil {
-		plan.Endpoint = types.StringValue(*config.Endpoint)
-	} else {
-		plan.Endpoint = types.StringNull()
-	}
-
-	if config.ExportIntervalSeconds != nil {
-		plan.ExportIntervalSeconds = types.Int64Value(int64(*config.ExportIntervalSeconds))
-	} else {
-		plan.ExportIntervalSeconds = types.Int64Null()
-	}
+	flattenOTLPConfig(ctx, &plan, config, &resp.Diagnostics)
 
 	// Set state
 	diags = resp.State.Set(ctx, plan)
@@ -296,14 +391,8 @@ func (r *organizationOTLPResource) Update(ctx context.Context, req resource.Upda
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *organizationOTLPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Disable OTLP export
-	updateReq := map[string]interface{}{
-		"enabled":  false,
-		"endpoint": "",
-	}
-
-	_, err := r.client.DoRequest("PUT", "/v1/org/otlp", updateReq)
-	if err != nil {
+	// Disable OTLP export and clear the stored endpoint and credentials
+	if err := r.client.DisableOTLP(); err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting organization OTLP config",
 			"Could not disable OTLP config, unexpected error: "+err.Error(),
@@ -316,7 +405,7 @@ func (r *organizationOTLPResource) Delete(ctx context.Context, req resource.Dele
 func (r *organizationOTLPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This is a singleton resource, so we just import it
 	// Retrieve the current OTLP config
-	config, err := r.getOTLPConfig(ctx)
+	config, err := r.client.GetOTLPConfig()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing organization OTLP config",
@@ -325,18 +414,15 @@ func (r *organizationOTLPResource) ImportState(ctx context.Context, req resource
 		return
 	}
 
-	// Create state from config
+	// Create state from config. Header values and the client key cannot be read,
+	// so the first plan after import updates them.
 	state := organizationOTLPResourceModel{
-		Enabled: types.BoolValue(config.Enabled),
-	}
-
-	if config.Endpoint != nil {
-		state.Endpoint = types.StringValue(*config.Endpoint)
-	}
-
-	if config.ExportIntervalSeconds != nil {
-		state.ExportIntervalSeconds = types.Int64Value(int64(*config.ExportIntervalSeconds))
+		Headers:           types.MapNull(types.StringType),
+		ClientCertificate: types.StringNull(),
+		ClientKey:         types.StringNull(),
+		CACertificate:     types.StringNull(),
 	}
+	flattenOTLPConfig(ctx, &state, config, &resp.Diagnostics)
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -345,27 +431,112 @@ func (r *organizationOTLPResource) ImportState(ctx context.Context, req resource
 	}
 }
 
-// OTLPConfigResponse represents the OTLP config API response
-type OTLPConfigResponse struct {
-	Enabled                bool                    `json:"otlp_enabled"`
-	Endpoint               *string                 `json:"otlp_endpoint"`
-	Headers                map[string]interface{}  `json:"otlp_headers"`
-	ExportIntervalSeconds  *int                    `json:"otlp_export_interval_seconds"`
+// expandOTLPConfig builds the API request from the resource model.
+func expandOTLPConfig(ctx context.Context, model organizationOTLPResourceModel) (client.UpdateOTLPConfigRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := client.UpdateOTLPConfigRequest{
+		Enabled:           model.Enabled.ValueBool(),
+		Endpoint:          model.Endpoint.ValueStringPointer(),
+		Protocol:          model.Protocol.ValueString(),
+		Compression:       model.Compression.ValueString(),
+		ClientCertificate: model.ClientCertificate.ValueStringPointer(),
+		ClientKey:         model.ClientKey.ValueStringPointer(),
+		CACertificate:     model.CACertificate.ValueStringPointer(),
+	}
+
+	if !model.ExportIntervalSeconds.IsNull() {
+		interval := int(model.ExportIntervalSeconds.ValueInt64())
+		req.ExportIntervalSeconds = &interval
+	}
+	if !model.Headers.IsNull() {
+		diags.Append(model.Headers.ElementsAs(ctx, &req.Headers, false)...)
+	}
+	if !model.ResourceAttributes.IsNull() {
+		diags.Append(model.ResourceAttributes.ElementsAs(ctx, &req.ResourceAttributes, false)...)
+	}
+	if !model.MetricFamilies.IsNull() {
+		diags.Append(model.MetricFamilies.ElementsAs(ctx, &req.MetricFamilies, false)...)
+	}
+
+	return req, diags
 }
 
-// getOTLPConfig fetches the current OTLP configuration
-func (r *organizationOTLPResource) getOTLPConfig(ctx context.Context) (*OTLPConfigResponse, error) {
-	data, err := r.client.DoRequest("GET", "/v1/org/otlp", nil)
-	if err != nil {
-		return nil, err
+// flattenOTLPConfig maps the API config onto the model. Write-only values (header
+// values and the client key) keep their current value; if headers_hash changed
+// since the last apply, the headers were modified outside Terraform and are
+// replaced by the redacted remote headers so the next plan restores them.
+func flattenOTLPConfig(ctx context.Context, model *organizationOTLPResourceModel, config *client.OTLPConfig, diags *diag.Diagnostics) {
+	model.Enabled = types.BoolValue(config.Enabled)
+	if config.Endpoint != nil && *config.Endpoint != "" {
+		model.Endpoint = types.StringValue(*config.Endpoint)
+	} else {
+		model.Endpoint = types.StringNull()
+	}
+	if config.Protocol != "" {
+		model.Protocol = types.StringValue(config.Protocol)
+	} else {
+		model.Protocol = types.StringValue(client.OTLPProtocolHTTPProtobuf)
+	}
+	if config.Compression != "" {
+		model.Compression = types.StringValue(config.Compression)
+	} else {
+		model.Compression = types.StringValue("none")
+	}
+	if config.ExportIntervalSeconds != nil {
+		model.ExportIntervalSeconds = types.Int64Value(int64(*config.ExportIntervalSeconds))
+	} else if model.ExportIntervalSeconds.IsNull() || model.ExportIntervalSeconds.IsUnknown() {
+		// Not returned by the API: keep the prior value, or the default on import
+		model.ExportIntervalSeconds = types.Int64Value(otlpDefaultExportIntervalSeconds)
+	}
+
+	previousHash := model.HeadersHash
+	if !previousHash.IsNull() && !previousHash.IsUnknown() && previousHash.ValueString() != config.HeadersHash {
+		diags.AddWarning(
+			"OTLP Headers Drift Detected",
+			"The OTLP export headers have been modified outside of Terraform. "+
+				"The headers_hash changed from "+previousHash.ValueString()+" to "+config.HeadersHash+". "+
+				"The next apply resets them to your Terraform-defined headers.",
+		)
+		if len(config.Headers) > 0 {
+			headers, d := types.MapValueFrom(ctx, types.StringType, config.Headers)
+			diags.Append(d...)
+			model.Headers = headers
+		} else {
+			model.Headers = types.MapNull(types.StringType)
+		}
 	}
+	model.HeadersHash = types.StringValue(config.HeadersHash)
 
-	var resp struct {
-		Data OTLPConfigResponse `json:"data"`
+	if len(config.ResourceAttributes) > 0 {
+		attributes, d := types.MapValueFrom(ctx, types.StringType, config.ResourceAttributes)
+		diags.Append(d...)
+		model.ResourceAttributes = attributes
+	} else {
+		model.ResourceAttributes = types.MapNull(types.StringType)
+	}
+	if len(config.MetricFamilies) > 0 {
+		families, d := types.SetValueFrom(ctx, types.StringType, config.MetricFamilies)
+		diags.Append(d...)
+		model.MetricFamilies = families
+	} else {
+		model.MetricFamilies = types.SetNull(types.StringType)
 	}
-	if err := client.UnmarshalAPIResponse(data, &resp); err != nil {
-		return nil, err
+
+	model.ClientCertificate = refreshPEM(model.ClientCertificate, config.ClientCertificate)
+	model.CACertificate = refreshPEM(model.CACertificate, config.CACertificate)
+	if config.ClientCertificate == nil {
+		model.ClientKey = types.StringNull()
 	}
+}
 
-	return &resp.Data, nil
+// refreshPEM returns the remote PEM value, keeping the current value if the two
+// only differ in surrounding whitespace.
+func refreshPEM(current types.String, remote *string) types.String {
+	if remote == nil || *remote == "" {
+		return types.StringNull()
+	}
+	if !current.IsNull() && !current.IsUnknown() && strings.TrimSpace(current.ValueString()) == strings.TrimSpace(*remote) {
+		return current
+	}
+	return types.StringValue(*remote)
 }
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// otlpStandIn is a local stand-in for the /v1/org/otlp API. Like the real API it
+// stores header values but only returns them redacted, together with a hash.
+type otlpStandIn struct {
+	config  client.OTLPConfig
+	headers map[string]string
+	lastPut map[string]interface{}
+}
+
+func (s *otlpStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v1/org/otlp" {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		var raw map[string]interface{}
+		var req client.UpdateOTLPConfigRequest
+		body := json.NewDecoder(r.Body)
+		if err := body.Decode(&raw); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.lastPut = raw
+		encoded, _ := json.Marshal(raw)
+		_ = json.Unmarshal(encoded, &req)
+
+		s.headers = req.Headers
+		s.config = client.OTLPConfig{
+			Enabled:               req.Enabled,
+			Endpoint:              req.Endpoint,
+			Protocol:              req.Protocol,
+			Compression:           req.Compression,
+			ExportIntervalSeconds: req.ExportIntervalSeconds,
+			ResourceAttributes:    req.ResourceAttributes,
+			MetricFamilies:        req.MetricFamilies,
+			ClientCertificate:     req.ClientCertificate,
+			CACertificate:         req.CACertificate,
+		}
+		fmt.Fprint(w, `{"data":{}}`)
+
+	case http.MethodGet:
+		config := s.config
+		config.Headers = map[string]string{}
+		for name := range s.headers {
+			config.Headers[name] = "***REDACTED***"
+		}
+		config.HeadersHash = hashOTLPHeaders(s.headers)
+		data, _ := json.Marshal(map[string]interface{}{"data": config})
+		fmt.Fprintf(w, `{"data":%s}`, data)
+
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func hashOTLPHeaders(headers map[string]string) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s=%s\n", name, headers[name])
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func TestOrganizationOTLPResource_StandIn(t *testing.T) {
+	standIn := &otlpStandIn{}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	c, err := client.New(server.URL, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newResourceHarness(t, NewOrganizationOTLPResource(), c)
+
+	headers := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Authorization": types.StringValue("Bearer secret"),
+	})
+	configured := organizationOTLPResourceModel{
+		Enabled:               types.BoolValue(true),
+		Endpoint:              types.StringValue("otlp.example.com:4317"),
+		Protocol:              types.StringValue(client.OTLPProtocolGRPC),
+		Compression:           types.StringValue("gzip"),
+		Headers:               headers,
+		HeadersHash:           types.StringUnknown(),
+		ExportIntervalSeconds: types.Int64Value(30),
+		ResourceAttributes: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"deployment.environment": types.StringValue("production"),
+		}),
+		MetricFamilies: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("uptime"),
+			types.StringValue("check_latency"),
+		}),
+		ClientCertificate: types.StringNull(),
+		ClientKey:         types.StringNull(),
+		CACertificate:     types.StringNull(),
+	}
+	secretHash := hashOTLPHeaders(map[string]string{"Authorization": "Bearer secret"})
+
+	// Create
+	state, diags := h.create(h.plan(configured))
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("unexpected diagnostics after create: %v", diags)
+	}
+	for key, want := range map[string]interface{}{"protocol": "grpc", "compression": "gzip", "export_interval_seconds": float64(30)} {
+		if got := standIn.lastPut[key]; got != want {
+			t.Errorf("expected %s = %v in the request, got %v", key, want, got)
+		}
+	}
+	var model organizationOTLPResourceModel
+	h.model(state, &model)
+	if !model.Headers.Equal(headers) {
+		t.Errorf("expected configured headers to be kept, got %s", model.Headers)
+	}
+	if model.HeadersHash.ValueString() != secretHash {
+		t.Errorf("unexpected headers_hash %s", model.HeadersHash)
+	}
+	if model.ExportIntervalSeconds.ValueInt64() != 30 || model.MetricFamilies.IsNull() || model.ResourceAttributes.IsNull() {
+		t.Errorf("expected interval, metric families and resource attributes in state, got %+v", model)
+	}
+
+	// Read without changes
+	state, diags = h.read(state)
+	h.model(state, &model)
+	if diags.HasError() || diags.WarningsCount() != 0 || !model.Headers.Equal(headers) {
+		t.Errorf("expected no drift, got %v and headers %s", diags, model.Headers)
+	}
+
+	// Headers changed outside Terraform
+	standIn.headers = map[string]string{"Authorization": "Bearer rotated"}
+	state, diags = h.read(state)
+	if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "OTLP Headers Drift Detected" {
+		t.Errorf("expected a drift warning, got %v", diags)
+	}
+	h.model(state, &model)
+	if model.Headers.Equal(headers) {
+		t.Error("expected drifted headers to differ from the configuration so the next plan restores them")
+	}
+
+	// The next apply restores the configured headers
+	state, diags = h.update(h.plan(configured), state)
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("unexpected diagnostics after update: %v", diags)
+	}
+	if standIn.headers["Authorization"] != "Bearer secret" {
+		t.Errorf("expected the configured header to be sent again, got %v", standIn.headers)
+	}
+	h.model(state, &model)
+	if !model.Headers.Equal(headers) || model.HeadersHash.ValueString() != secretHash {
+		t.Errorf("expected configured headers and their hash in state, got %s and %s", model.Headers, model.HeadersHash)
+	}
+
+	// Delete clears the endpoint instead of sending an empty one
+	if diags := h.delete(state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics after delete: %v", diags)
+	}
+	if endpoint, ok := standIn.lastPut["endpoint"]; !ok || endpoint != nil {
+		t.Errorf("expected endpoint to be cleared with null, got %v", endpoint)
+	}
+	if standIn.lastPut["enabled"] != false {
+		t.Errorf("expected export to be disabled, got %v", standIn.lastPut["enabled"])
+	}
+}
+
+func TestOrganizationOTLPResource_ModifyPlanAfterDowngrade(t *testing.T) {
+	c, err := client.New("http://localhost", "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Organization = testTierOrganization()
+	h := newResourceHarness(t, NewOrganizationOTLPResource(), c)
+
+	model := organizationOTLPResourceModel{
+		Enabled:               types.BoolValue(true),
+		Endpoint:              types.StringValue("otlp.example.com:4317"),
+		Protocol:              types.StringValue(client.OTLPProtocolGRPC),
+		Compression:           types.StringValue("gzip"),
+		Headers:               types.MapNull(types.StringType),
+		HeadersHash:           types.StringValue(""),
+		ExportIntervalSeconds: types.Int64Value(60),
+		ResourceAttributes:    types.MapNull(types.StringType),
+		MetricFamilies:        types.SetNull(types.StringType),
+		ClientCertificate:     types.StringNull(),
+		ClientKey:             types.StringNull(),
+		CACertificate:         types.StringNull(),
+	}
+	state := h.state(model)
+
+	// An unchanged export keeps planning cleanly on a tier without OTLP
+	if _, diags := h.modifyPlan(h.plan(model), state); diags.HasError() {
+		t.Errorf("expected no error for an unchanged export, got %v", diags)
+	}
+
+	// Changing it is rejected
+	model.ExportIntervalSeconds = types.Int64Value(30)
+	_, diags := h.modifyPlan(h.plan(model), state)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Feature Not Available on Tier" {
+		t.Errorf("expected a tier error for a changed export, got %v", diags)
+	}
+}
+
+func TestOrganizationOTLPResource_ValidateConfig(t *testing.T) {
+	h := newResourceHarness(t, NewOrganizationOTLPResource(), nil)
+
+	diags := h.validateConfig(organizationOTLPResourceModel{
+		Enabled:               types.BoolValue(true),
+		Endpoint:              types.StringValue("https://otlp.example.com:4318/v1/metrics"),
+		Protocol:              types.StringNull(),
+		Compression:           types.StringNull(),
+		Headers:               types.MapNull(types.StringType),
+		HeadersHash:           types.StringNull(),
+		ExportIntervalSeconds: types.Int64Null(),
+		ResourceAttributes:    types.MapNull(types.StringType),
+		MetricFamilies:        types.SetNull(types.StringType),
+		ClientCertificate:     types.StringValue("not a certificate"),
+		ClientKey:             types.StringValue("not a key"),
+		CACertificate:         types.StringValue("not a CA"),
+	})
+
+	// The PEM diagnostics are reported in schema order on every run
+	var got []string
+	for _, d := range diags.Errors() {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok && d.Summary() == "Invalid PEM" {
+			got = append(got, withPath.Path().String())
+		}
+	}
+	want := []string{"client_certificate", "client_key", "ca_certificate"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected Invalid PEM errors on %v, got %v", want, got)
+	}
+}
+
+func TestRefreshPEM(t *testing.T) {
+	pem := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"
+	remote := pem + "\n"
+
+	if got := refreshPEM(types.StringValue(pem), &remote); got.ValueString() != pem {
+		t.Errorf("expected the configured value to be kept, got %q", got.ValueString())
+	}
+	other := "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----"
+	if got := refreshPEM(types.StringValue(pem), &other); got.ValueString() != other {
+		t.Errorf("expected the remote value, got %q", got.ValueString())
+	}
+	if got := refreshPEM(types.StringValue(pem), nil); !got.IsNull() {
+		t.Errorf("expected null, got %q", got.ValueString())
+	}
+}
+
+func TestFlattenOTLPConfig_ExportIntervalOmitted(t *testing.T) {
+	var diags diag.Diagnostics
+	config := &client.OTLPConfig{Enabled: true}
+
+	// Imported: the default
+	model := organizationOTLPResourceModel{ExportIntervalSeconds: types.Int64Null(), HeadersHash: types.StringNull()}
+	flattenOTLPConfig(context.Background(), &model, config, &diags)
+	if model.ExportIntervalSeconds.ValueInt64() != 60 {
+		t.Errorf("expected the default interval, got %s", model.ExportIntervalSeconds)
+	}
+
+	// Configured: the prior value
+	model.ExportIntervalSeconds = types.Int64Value(30)
+	flattenOTLPConfig(context.Background(), &model, config, &diags)
+	if model.ExportIntervalSeconds.ValueInt64() != 30 {
+		t.Errorf("expected the prior interval to be kept, got %s", model.ExportIntervalSeconds)
+	}
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestAccOrganizationOTLPResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "quismon_organization_otlp" "test" {
+  enabled                 = true
+  endpoint                = "https://otlp.example.com:4318/v1/metrics"
+  compression             = "gzip"
+  export_interval_seconds = 30
+  metric_families         = ["uptime", "check_latency"]
+
+  headers = {
+    Authorization = "Bearer test"
+  }
+
+  resource_attributes = {
+    "deployment.environment" = "test"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_organization_otlp.test", "protocol", "http/protobuf"),
+					resource.TestCheckResourceAttr("quismon_organization_otlp.test", "export_interval_seconds", "30"),
+					resource.TestCheckResourceAttr("quismon_organization_otlp.test", "metric_families.#", "2"),
+					resource.TestCheckResourceAttrSet("quismon_organization_otlp.test", "headers_hash"),
+				),
+			},
+			{
+				Config: `
+resource "quismon_organization_otlp" "test" {
+  enabled  = true
+  endpoint = "http://otlp.example.com:4318/v1/metrics"
+
+  client_certificate = "not a certificate"
+}
+`,
+				ExpectError: regexp.MustCompile(`Incomplete mTLS Configuration`),
+			},
+			{
+				Config: `
+resource "quismon_organization_otlp" "test" {
+  enabled         = true
+  endpoint        = "https://otlp.example.com:4318/v1/metrics"
+  metric_families = ["everything"]
+}
+`,
+				ExpectError: regexp.MustCompile(`metric_families`),
+			},
+		},
+	})
+}
@@ -0,0 +1,636 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// prometheusLabelNameRegexp matches valid Prometheus label names.
+var prometheusLabelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &organizationPrometheusResource{}
+	_ resource.ResourceWithConfigure      = &organizationPrometheusResource{}
+	_ resource.ResourceWithImportState    = &organizationPrometheusResource{}
+	_ resource.ResourceWithModifyPlan     = &organizationPrometheusResource{}
+	_ resource.ResourceWithValidateConfig = &organizationPrometheusResource{}
+)
+
+// NewOrganizationPrometheusResource is a helper function to simplify the provider implementation.
+func NewOrganizationPrometheusResource() resource.Resource {
+	return &organizationPrometheusResource{}
+}
+
+// organizationPrometheusResource is the resource implementation.
+type organizationPrometheusResource struct {
+	client *client.Client
+}
+
+// organizationPrometheusResourceModel maps the resource schema data.
+type organizationPrometheusResourceModel struct {
+	RemoteWrite                *prometheusRemoteWriteModel `tfsdk:"remote_write"`
+	RemoteWriteCredentialsHash types.String                `tfsdk:"remote_write_credentials_hash"`
+	ScrapeEndpointEnabled      types.Bool                  `tfsdk:"scrape_endpoint_enabled"`
+	ScrapeURL                  types.String                `tfsdk:"scrape_url"`
+	ScrapeToken                types.String                `tfsdk:"scrape_token"`
+}
+
+// prometheusRemoteWriteModel maps the nested remote_write object.
+type prometheusRemoteWriteModel struct {
+	URL                 types.String                 `tfsdk:"url"`
+	BasicAuth           *prometheusBasicAuthModel    `tfsdk:"basic_auth"`
+	BearerToken         types.String                 `tfsdk:"bearer_token"`
+	SendIntervalSeconds types.Int64                  `tfsdk:"send_interval_seconds"`
+	RelabelRules        []prometheusRelabelRuleModel `tfsdk:"relabel_rules"`
+}
+
+// prometheusBasicAuthModel maps the nested basic_auth object.
+type prometheusBasicAuthModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+// prometheusRelabelRuleModel maps a single relabel rule.
+type prometheusRelabelRuleModel struct {
+	Action       types.String `tfsdk:"action"`
+	SourceLabels types.List   `tfsdk:"source_labels"`
+	Separator    types.String `tfsdk:"separator"`
+	Regex        types.String `tfsdk:"regex"`
+	TargetLabel  types.String `tfsdk:"target_label"`
+	Replacement  types.String `tfsdk:"replacement"`
+}
+
+// Metadata returns the resource type name.
+func (r *organizationPrometheusResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_prometheus"
+}
+
+// Schema defines the schema for the resource.
+func (r *organizationPrometheusResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configure Prometheus metrics export for the organization, either by remote-write to Prometheus, Mimir or another compatible backend, " +
+			"or through an authenticated /metrics endpoint that Prometheus scrapes. " +
+			"This is a PAID feature and requires a 'paid' or 'enterprise' tier subscription.",
+		Attributes: map[string]schema.Attribute{
+			"remote_write": schema.SingleNestedAttribute{
+				Description: "Push metrics with the Prometheus remote-write protocol. Conflicts with scrape_endpoint_enabled.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "Remote-write URL (e.g., https://mimir.example.com/api/v1/push).",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http:// or https:// URL"),
+						},
+					},
+					"basic_auth": schema.SingleNestedAttribute{
+						Description: "HTTP basic authentication. Conflicts with bearer_token.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"username": schema.StringAttribute{
+								Description: "Username.",
+								Required:    true,
+							},
+							"password": schema.StringAttribute{
+								Description: "Password. Write-only: a password changed outside Terraform is detected via remote_write_credentials_hash and sent again on the next apply.",
+								Required:    true,
+								Sensitive:   true,
+							},
+						},
+					},
+					"bearer_token": schema.StringAttribute{
+						Description: "Bearer token sent in the Authorization header. Write-only: a token rotated outside Terraform is detected via remote_write_credentials_hash and sent again on the next apply.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"send_interval_seconds": schema.Int64Attribute{
+						Description: "How often to send metrics in seconds (minimum 10, default 60).",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(60),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(10),
+						},
+					},
+					"relabel_rules": schema.ListNestedAttribute{
+						Description: "Prometheus relabel rules applied in order before sending, e.g. to drop series or rename labels.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"action": schema.StringAttribute{
+									Description: "Relabel action: replace, keep, drop, labelkeep, or labeldrop. Default is replace.",
+									Optional:    true,
+									Computed:    true,
+									Default:     stringdefault.StaticString("replace"),
+									Validators: []validator.String{
+										stringvalidator.OneOf(client.PrometheusRelabelActions...),
+									},
+								},
+								"source_labels": schema.ListAttribute{
+									Description: "Labels whose values are joined with separator and matched against regex.",
+									Optional:    true,
+									ElementType: types.StringType,
+									Validators: []validator.List{
+										listvalidator.ValueStringsAre(stringvalidator.RegexMatches(prometheusLabelNameRegexp, "must be a valid Prometheus label name")),
+									},
+								},
+								"separator": schema.StringAttribute{
+									Description: "Separator between joined source label values. Default is ;.",
+									Optional:    true,
+								},
+								"regex": schema.StringAttribute{
+									Description: "RE2 regular expression matched against the joined source label values, or against label names for labelkeep and labeldrop.",
+									Optional:    true,
+								},
+								"target_label": schema.StringAttribute{
+									Description: "Label written by the replace action.",
+									Optional:    true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(prometheusLabelNameRegexp, "must be a valid Prometheus label name"),
+									},
+								},
+								"replacement": schema.StringAttribute{
+									Description: "Value written by the replace action; may reference regex capture groups such as $1.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"remote_write_credentials_hash": schema.StringAttribute{
+				Description: "Hash of the remote-write username, password and bearer token, used to detect changes made outside Terraform. Null without remote_write.",
+				Computed:    true,
+			},
+			"scrape_endpoint_enabled": schema.BoolAttribute{
+				Description: "Expose an authenticated /metrics endpoint for Prometheus to scrape. Conflicts with remote_write. Default is false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"scrape_url": schema.StringAttribute{
+				Description: "URL of the /metrics endpoint. Null unless scrape_endpoint_enabled is true.",
+				Computed:    true,
+			},
+			"scrape_token": schema.StringAttribute{
+				Description: "Bearer token for the /metrics endpoint, e.g. for authorization.credentials in a Prometheus scrape config. " +
+					"Null unless scrape_endpoint_enabled is true. Disabling and re-enabling the endpoint issues a new token.",
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *organizationPrometheusResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that exactly one export mode is configured and that
+// relabel rules have the fields their action needs.
+func (r *organizationPrometheusResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config organizationPrometheusResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scrape := config.ScrapeEndpointEnabled.ValueBool()
+	switch {
+	case config.RemoteWrite != nil && scrape:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scrape_endpoint_enabled"),
+			"Conflicting Prometheus Export Modes",
+			"Configure either remote_write or scrape_endpoint_enabled, not both.",
+		)
+	case config.RemoteWrite == nil && !scrape && !config.ScrapeEndpointEnabled.IsUnknown():
+		resp.Diagnostics.AddError(
+			"Missing Prometheus Export Mode",
+			"Configure remote_write or set scrape_endpoint_enabled = true.",
+		)
+	}
+
+	if config.RemoteWrite == nil {
+		return
+	}
+	remoteWritePath := path.Root("remote_write")
+
+	if config.RemoteWrite.BasicAuth != nil && !config.RemoteWrite.BearerToken.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			remoteWritePath.AtName("bearer_token"),
+			"Conflicting Remote-Write Authentication",
+			"Configure either basic_auth or bearer_token, not both.",
+		)
+	}
+
+	for i, rule := range config.RemoteWrite.RelabelRules {
+		for _, problem := range validatePrometheusRelabelRule(rule) {
+			resp.Diagnostics.AddAttributeError(
+				remoteWritePath.AtName("relabel_rules").AtListIndex(i),
+				"Invalid Relabel Rule",
+				problem,
+			)
+		}
+	}
+}
+
+// validatePrometheusRelabelRule returns the problems of a single relabel rule.
+// Unknown values are not validated.
+func validatePrometheusRelabelRule(rule prometheusRelabelRuleModel) []string {
+	var problems []string
+
+	if !rule.Regex.IsNull() && !rule.Regex.IsUnknown() {
+		if _, err := regexp.Compile("^(?:" + rule.Regex.ValueString() + ")$"); err != nil {
+			problems = append(problems, fmt.Sprintf("regex %q is invalid: %s", rule.Regex.ValueString(), err))
+		}
+	}
+
+	if rule.Action.IsUnknown() {
+		return problems
+	}
+	action := rule.Action.ValueString()
+	if rule.Action.IsNull() {
+		action = "replace"
+	}
+
+	switch action {
+	case "replace":
+		if rule.TargetLabel.IsNull() {
+			problems = append(problems, "target_label is required for the replace action")
+		}
+	case "keep", "drop":
+		if rule.SourceLabels.IsNull() {
+			problems = append(problems, "source_labels is required for the "+action+" action")
+		}
+	case "labelkeep", "labeldrop":
+		if rule.Regex.IsNull() {
+			problems = append(problems, "regex is required for the "+action+" action")
+		}
+		if !rule.SourceLabels.IsNull() || !rule.TargetLabel.IsNull() || !rule.Replacement.IsNull() {
+			problems = append(problems, "the "+action+" action only uses regex")
+		}
+	}
+
+	return problems
+}
+
+// ModifyPlan keeps the scrape URL and token known while the scrape endpoint stays
+// enabled and the credentials hash known while the remote-write credentials are
+// unchanged, and fails the plan early when the organization's tier does not
+// include Prometheus export.
+func (r *organizationPrometheusResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var planScrape, stateScrape types.Bool
+		var stateURL, stateToken types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("scrape_endpoint_enabled"), &planScrape)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("scrape_endpoint_enabled"), &stateScrape)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("scrape_url"), &stateURL)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("scrape_token"), &stateToken)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if planScrape.Equal(stateScrape) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("scrape_url"), stateURL)...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("scrape_token"), stateToken)...)
+		}
+
+		var planRemoteWrite, stateRemoteWrite *prometheusRemoteWriteModel
+		var stateHash types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("remote_write"), &planRemoteWrite)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("remote_write"), &stateRemoteWrite)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("remote_write_credentials_hash"), &stateHash)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if samePrometheusCredentials(planRemoteWrite, stateRemoteWrite) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("remote_write_credentials_hash"), stateHash)...)
+		}
+	}
+
+	if r.client == nil || r.client.Organization == nil {
+		return
+	}
+
+	// Don't fail plans for an unchanged export, e.g. after a downgrade
+	if !req.State.Raw.IsNull() && resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	// Report the error on the export that is configured
+	var remoteWrite *prometheusRemoteWriteModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("remote_write"), &remoteWrite)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	attrPath := path.Root("remote_write")
+	if remoteWrite == nil {
+		attrPath = path.Root("scrape_endpoint_enabled")
+	}
+
+	resp.Diagnostics.Append(checkPlanFeature(r.client.Organization, "prometheus", "Prometheus metrics export", attrPath)...)
+}
+
+// Create creates the resource and sets initial Terraform state.
+func (r *organizationPrometheusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationPrometheusResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := expandPrometheusConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.UpdatePrometheusConfig(updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Prometheus Export",
+			"Could not configure Prometheus export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapPrometheusScrapeEndpoint(&plan, config)
+	mapPrometheusCredentialsHash(&plan, config)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *organizationPrometheusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationPrometheusResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetPrometheusConfig()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Prometheus Export",
+			"Could not read Prometheus export config: "+err.Error(),
+		)
+		return
+	}
+
+	if config.RemoteWrite == nil && !config.ScrapeEndpointEnabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.RemoteWrite, diags = flattenPrometheusRemoteWrite(ctx, config.RemoteWrite, state.RemoteWrite)
+	resp.Diagnostics.Append(diags...)
+	mapPrometheusScrapeEndpoint(&state, config)
+
+	// The password and bearer token are write-only: forget them when they were
+	// changed outside Terraform so that the next plan sends them again.
+	if state.RemoteWrite != nil && secretHashChanged(state.RemoteWriteCredentialsHash, config.RemoteWrite.CredentialsHash) {
+		resp.Diagnostics.AddWarning(
+			"Prometheus Remote-Write Credentials Drift Detected",
+			"The remote-write credentials have been modified outside of Terraform. "+
+				"The remote_write_credentials_hash changed from "+state.RemoteWriteCredentialsHash.ValueString()+" to "+config.RemoteWrite.CredentialsHash+". "+
+				"The next apply resets them to your Terraform-defined credentials.",
+		)
+		state.RemoteWrite.BearerToken = types.StringNull()
+		if state.RemoteWrite.BasicAuth != nil {
+			state.RemoteWrite.BasicAuth.Password = types.StringNull()
+		}
+	}
+	mapPrometheusCredentialsHash(&state, config)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *organizationPrometheusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan organizationPrometheusResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := expandPrometheusConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.UpdatePrometheusConfig(updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Prometheus Export",
+			"Could not update Prometheus export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The token is only returned when the endpoint is first enabled; otherwise
+	// ModifyPlan has already copied it from state
+	mapPrometheusScrapeEndpoint(&plan, config)
+	mapPrometheusCredentialsHash(&plan, config)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *organizationPrometheusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if err := r.client.DeletePrometheusConfig(); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Prometheus Export",
+			"Could not disable Prometheus export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the organization's Prometheus export configuration. Any ID
+// is accepted since the resource is a singleton. Credentials and the scrape token
+// cannot be read and are null after import.
+func (r *organizationPrometheusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	config, err := r.client.GetPrometheusConfig()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Prometheus Export",
+			"Could not read Prometheus export config: "+err.Error(),
+		)
+		return
+	}
+
+	var state organizationPrometheusResourceModel
+	var diags diag.Diagnostics
+	state.RemoteWrite, diags = flattenPrometheusRemoteWrite(ctx, config.RemoteWrite, nil)
+	resp.Diagnostics.Append(diags...)
+	mapPrometheusScrapeEndpoint(&state, config)
+	mapPrometheusCredentialsHash(&state, config)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// mapPrometheusScrapeEndpoint maps the scrape endpoint settings. The token is kept
+// from the model when the API does not return it.
+func mapPrometheusScrapeEndpoint(model *organizationPrometheusResourceModel, config *client.PrometheusConfig) {
+	model.ScrapeEndpointEnabled = types.BoolValue(config.ScrapeEndpointEnabled)
+	if !config.ScrapeEndpointEnabled {
+		model.ScrapeURL = types.StringNull()
+		model.ScrapeToken = types.StringNull()
+		return
+	}
+	model.ScrapeURL = types.StringValue(config.ScrapeURL)
+	if config.ScrapeToken != "" {
+		model.ScrapeToken = types.StringValue(config.ScrapeToken)
+	} else if model.ScrapeToken.IsUnknown() {
+		model.ScrapeToken = types.StringNull()
+	}
+}
+
+// mapPrometheusCredentialsHash maps the hash of the remote-write credentials.
+func mapPrometheusCredentialsHash(model *organizationPrometheusResourceModel, config *client.PrometheusConfig) {
+	if config.RemoteWrite == nil {
+		model.RemoteWriteCredentialsHash = types.StringNull()
+		return
+	}
+	model.RemoteWriteCredentialsHash = types.StringValue(config.RemoteWrite.CredentialsHash)
+}
+
+// samePrometheusCredentials reports whether two remote-write settings have the
+// same username, password and bearer token.
+func samePrometheusCredentials(a, b *prometheusRemoteWriteModel) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if !a.BearerToken.Equal(b.BearerToken) {
+		return false
+	}
+	if a.BasicAuth == nil || b.BasicAuth == nil {
+		return a.BasicAuth == nil && b.BasicAuth == nil
+	}
+	return a.BasicAuth.Username.Equal(b.BasicAuth.Username) && a.BasicAuth.Password.Equal(b.BasicAuth.Password)
+}
+
+// expandPrometheusConfig builds the API request from the resource model.
+func expandPrometheusConfig(ctx context.Context, model organizationPrometheusResourceModel) (client.PrometheusConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := client.PrometheusConfig{
+		ScrapeEndpointEnabled: model.ScrapeEndpointEnabled.ValueBool(),
+	}
+
+	rw := model.RemoteWrite
+	if rw == nil {
+		return config, diags
+	}
+
+	config.RemoteWrite = &client.PrometheusRemoteWrite{
+		URL:                 rw.URL.ValueString(),
+		BearerToken:         rw.BearerToken.ValueString(),
+		SendIntervalSeconds: int(rw.SendIntervalSeconds.ValueInt64()),
+	}
+	if rw.BasicAuth != nil {
+		config.RemoteWrite.Username = rw.BasicAuth.Username.ValueString()
+		config.RemoteWrite.Password = rw.BasicAuth.Password.ValueString()
+	}
+	for _, rule := range rw.RelabelRules {
+		apiRule := client.PrometheusRelabelRule{
+			Action:      rule.Action.ValueString(),
+			Separator:   rule.Separator.ValueString(),
+			Regex:       rule.Regex.ValueString(),
+			TargetLabel: rule.TargetLabel.ValueString(),
+			Replacement: rule.Replacement.ValueString(),
+		}
+		if !rule.SourceLabels.IsNull() {
+			diags.Append(rule.SourceLabels.ElementsAs(ctx, &apiRule.SourceLabels, false)...)
+		}
+		config.RemoteWrite.RelabelRules = append(config.RemoteWrite.RelabelRules, apiRule)
+	}
+
+	return config, diags
+}
+
+// flattenPrometheusRemoteWrite maps the API remote-write settings, keeping the
+// write-only password and bearer token from current.
+func flattenPrometheusRemoteWrite(ctx context.Context, rw *client.PrometheusRemoteWrite, current *prometheusRemoteWriteModel) (*prometheusRemoteWriteModel, diag.Diagnostics) {
+	if rw == nil {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+	result := &prometheusRemoteWriteModel{
+		URL:                 types.StringValue(rw.URL),
+		BearerToken:         types.StringNull(),
+		SendIntervalSeconds: types.Int64Value(int64(rw.SendIntervalSeconds)),
+	}
+	if current != nil {
+		result.BearerToken = current.BearerToken
+	}
+	if rw.Username != "" {
+		result.BasicAuth = &prometheusBasicAuthModel{
+			Username: types.StringValue(rw.Username),
+			Password: types.StringNull(),
+		}
+		if current != nil && current.BasicAuth != nil {
+			result.BasicAuth.Password = current.BasicAuth.Password
+		}
+	}
+
+	for _, rule := range rw.RelabelRules {
+		sourceLabels := types.ListNull(types.StringType)
+		if len(rule.SourceLabels) > 0 {
+			var d diag.Diagnostics
+			sourceLabels, d = types.ListValueFrom(ctx, types.StringType, rule.SourceLabels)
+			diags.Append(d...)
+		}
+		result.RelabelRules = append(result.RelabelRules, prometheusRelabelRuleModel{
+			Action:       types.StringValue(rule.Action),
+			SourceLabels: sourceLabels,
+			Separator:    optionalString(rule.Separator),
+			Regex:        optionalString(rule.Regex),
+			TargetLabel:  optionalString(rule.TargetLabel),
+			Replacement:  optionalString(rule.Replacement),
+		})
+	}
+
+	return result, diags
+}
@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+func TestValidatePrometheusRelabelRule(t *testing.T) {
+	labels := func(names ...string) types.List {
+		values := make([]attr.Value, 0, len(names))
+		for _, name := range names {
+			values = append(values, types.StringValue(name))
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+	rule := func(action string) prometheusRelabelRuleModel {
+		return prometheusRelabelRuleModel{
+			Action:       types.StringValue(action),
+			SourceLabels: types.ListNull(types.StringType),
+			Separator:    types.StringNull(),
+			Regex:        types.StringNull(),
+			TargetLabel:  types.StringNull(),
+			Replacement:  types.StringNull(),
+		}
+	}
+
+	replace := rule("replace")
+	replace.SourceLabels = labels("check_name")
+	replace.Regex = types.StringValue("prod-(.*)")
+	replace.TargetLabel = types.StringValue("service")
+	replace.Replacement = types.StringValue("$1")
+
+	drop := rule("drop")
+	drop.SourceLabels = labels("region")
+	drop.Regex = types.StringValue("ap-.*")
+
+	labelDrop := rule("labeldrop")
+	labelDrop.Regex = types.StringValue("internal_.*")
+
+	replaceWithoutTarget := rule("replace")
+	keepWithoutSource := rule("keep")
+	labelKeepWithTarget := rule("labelkeep")
+	labelKeepWithTarget.Regex = types.StringValue("check_.*")
+	labelKeepWithTarget.TargetLabel = types.StringValue("service")
+	badRegex := drop
+	badRegex.Regex = types.StringValue("ap-(")
+
+	testCases := []struct {
+		name    string
+		rule    prometheusRelabelRuleModel
+		wantErr string
+	}{
+		{"replace", replace, ""},
+		{"drop", drop, ""},
+		{"labeldrop", labelDrop, ""},
+		{"replace without target_label", replaceWithoutTarget, "target_label is required"},
+		{"keep without source_labels", keepWithoutSource, "source_labels is required"},
+		{"labelkeep with target_label", labelKeepWithTarget, "only uses regex"},
+		{"invalid regex", badRegex, "is invalid"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			problems := validatePrometheusRelabelRule(tc.rule)
+			if tc.wantErr == "" {
+				if len(problems) != 0 {
+					t.Errorf("expected no problems, got: %v", problems)
+				}
+				return
+			}
+			if len(problems) == 0 || !strings.Contains(strings.Join(problems, "\n"), tc.wantErr) {
+				t.Errorf("expected a problem containing %q, got: %v", tc.wantErr, problems)
+			}
+		})
+	}
+}
+
+func TestPrometheusRemoteWriteKeepsCredentials(t *testing.T) {
+	ctx := context.Background()
+	configured := &prometheusRemoteWriteModel{
+		URL: types.StringValue("https://mimir.example.com/api/v1/push"),
+		BasicAuth: &prometheusBasicAuthModel{
+			Username: types.StringValue("quismon"),
+			Password: types.StringValue("secret"),
+		},
+		BearerToken:         types.StringNull(),
+		SendIntervalSeconds: types.Int64Value(30),
+	}
+
+	req, diags := expandPrometheusConfig(ctx, organizationPrometheusResourceModel{
+		RemoteWrite:           configured,
+		ScrapeEndpointEnabled: types.BoolValue(false),
+	})
+	if diags.HasError() {
+		t.Fatalf("expand: %v", diags)
+	}
+	if req.RemoteWrite.Username != "quismon" || req.RemoteWrite.Password != "secret" {
+		t.Errorf("expected basic auth in the request, got %+v", req.RemoteWrite)
+	}
+
+	// The API never returns the password
+	remote := *req.RemoteWrite
+	remote.Password = ""
+	flattened, diags := flattenPrometheusRemoteWrite(ctx, &remote, configured)
+	if diags.HasError() {
+		t.Fatalf("flatten: %v", diags)
+	}
+	if !flattened.BasicAuth.Password.Equal(types.StringValue("secret")) {
+		t.Errorf("expected the password to be kept from state, got %s", flattened.BasicAuth.Password)
+	}
+	if flattened.SendIntervalSeconds.ValueInt64() != 30 || flattened.RelabelRules != nil {
+		t.Errorf("unexpected remote_write after read: %+v", flattened)
+	}
+}
+
+func TestOrganizationPrometheusResource_CredentialsDrift(t *testing.T) {
+	credentialsHash := "a1b2c3"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/org/prometheus" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"data":{"remote_write":{"url":"https://mimir.example.com/api/v1/push","username":"quismon",`+
+			`"send_interval_seconds":60,"credentials_hash":%q},"scrape_endpoint_enabled":false}}`, credentialsHash)
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newResourceHarness(t, NewOrganizationPrometheusResource(), c)
+
+	state := h.state(organizationPrometheusResourceModel{
+		RemoteWrite: &prometheusRemoteWriteModel{
+			URL: types.StringValue("https://mimir.example.com/api/v1/push"),
+			BasicAuth: &prometheusBasicAuthModel{
+				Username: types.StringValue("quismon"),
+				Password: types.StringValue("secret"),
+			},
+			BearerToken:         types.StringNull(),
+			SendIntervalSeconds: types.Int64Value(60),
+		},
+		RemoteWriteCredentialsHash: types.StringValue(credentialsHash),
+		ScrapeEndpointEnabled:      types.BoolValue(false),
+		ScrapeURL:                  types.StringNull(),
+		ScrapeToken:                types.StringNull(),
+	})
+
+	state, diags := h.read(state)
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("expected no drift, got %v", diags)
+	}
+	var model organizationPrometheusResourceModel
+	h.model(state, &model)
+	if model.RemoteWrite.BasicAuth.Password.ValueString() != "secret" {
+		t.Errorf("expected the password to be kept, got %s", model.RemoteWrite.BasicAuth.Password)
+	}
+
+	// Password changed outside Terraform
+	credentialsHash = "d4e5f6"
+	state, diags = h.read(state)
+	if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Prometheus Remote-Write Credentials Drift Detected" {
+		t.Fatalf("expected a drift warning, got %v", diags)
+	}
+	h.model(state, &model)
+	if !model.RemoteWrite.BasicAuth.Password.IsNull() {
+		t.Errorf("expected the password to be forgotten so the next plan sends it, got %s", model.RemoteWrite.BasicAuth.Password)
+	}
+	if model.RemoteWriteCredentialsHash.ValueString() != "d4e5f6" {
+		t.Errorf("expected the new hash in state, got %s", model.RemoteWriteCredentialsHash)
+	}
+}
+
+func TestOrganizationPrometheusResource_ModifyPlanTier(t *testing.T) {
+	c, err := client.New("http://localhost", "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Organization = testTierOrganization()
+	h := newResourceHarness(t, NewOrganizationPrometheusResource(), c)
+
+	scrapeOnly := organizationPrometheusResourceModel{
+		RemoteWriteCredentialsHash: types.StringNull(),
+		ScrapeEndpointEnabled:      types.BoolValue(true),
+		ScrapeURL:                  types.StringValue("https://api.quismon.com/v1/org/prometheus/metrics"),
+		ScrapeToken:                types.StringValue("token"),
+	}
+
+	// A new scrape endpoint is rejected on the scrape_endpoint_enabled attribute
+	_, diags := h.modifyPlan(h.plan(scrapeOnly), h.emptyState())
+	if !diags.HasError() {
+		t.Fatal("expected a tier error")
+	}
+	if got := diags.Errors()[0].(diag.DiagnosticWithPath).Path(); !got.Equal(path.Root("scrape_endpoint_enabled")) {
+		t.Errorf("expected the error on scrape_endpoint_enabled, got %s", got)
+	}
+
+	// An unchanged export keeps planning cleanly, e.g. after a downgrade
+	if _, diags := h.modifyPlan(h.plan(scrapeOnly), h.state(scrapeOnly)); diags.HasError() {
+		t.Errorf("expected no error for an unchanged export, got %v", diags)
+	}
+}
+
+func TestMapPrometheusScrapeEndpoint(t *testing.T) {
+	model := organizationPrometheusResourceModel{
+		ScrapeURL:   types.StringUnknown(),
+		ScrapeToken: types.StringUnknown(),
+	}
+	mapPrometheusScrapeEndpoint(&model, &client.PrometheusConfig{
+		ScrapeEndpointEnabled: true,
+		ScrapeURL:             "https://api.quismon.com/v1/org/prometheus/metrics",
+		ScrapeToken:           "qm_scrape_123",
+	})
+	if model.ScrapeToken.ValueString() != "qm_scrape_123" || model.ScrapeURL.IsNull() {
+		t.Fatalf("expected the scrape URL and token, got %+v", model)
+	}
+
+	// Reads do not return the token
+	mapPrometheusScrapeEndpoint(&model, &client.PrometheusConfig{
+		ScrapeEndpointEnabled: true,
+		ScrapeURL:             "https://api.quismon.com/v1/org/prometheus/metrics",
+	})
+	if model.ScrapeToken.ValueString() != "qm_scrape_123" {
+		t.Errorf("expected the token to be kept, got %s", model.ScrapeToken)
+	}
+
+	mapPrometheusScrapeEndpoint(&model, &client.PrometheusConfig{})
+	if !model.ScrapeURL.IsNull() || !model.ScrapeToken.IsNull() {
+		t.Errorf("expected no scrape endpoint once disabled, got %+v", model)
+	}
+}
+
+func TestAccOrganizationPrometheusResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "quismon_organization_prometheus" "test" {
+  scrape_endpoint_enabled = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quismon_organization_prometheus.test", "scrape_url"),
+					resource.TestCheckResourceAttrSet("quismon_organization_prometheus.test", "scrape_token"),
+				),
+			},
+			{
+				Config: `
+resource "quismon_organization_prometheus" "test" {
+  remote_write = {
+    url          = "https://mimir.example.com/api/v1/push"
+    bearer_token = "test-token"
+
+    relabel_rules = [
+      {
+        action        = "drop"
+        source_labels = ["region"]
+        regex         = "ap-.*"
+      },
+    ]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_organization_prometheus.test", "scrape_endpoint_enabled", "false"),
+					resource.TestCheckResourceAttr("quismon_organization_prometheus.test", "remote_write.send_interval_seconds", "60"),
+					resource.TestCheckResourceAttr("quismon_organization_prometheus.test", "remote_write.relabel_rules.#", "1"),
+					resource.TestCheckNoResourceAttr("quismon_organization_prometheus.test", "scrape_url"),
+				),
+			},
+			{
+				Config: `
+resource "quismon_organization_prometheus" "test" {
+  scrape_endpoint_enabled = true
+
+  remote_write = {
+    url = "https://mimir.example.com/api/v1/push"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Conflicting Prometheus Export Modes`),
+			},
+		},
+	})
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &organizationResource{}
+	_ resource.ResourceWithConfigure   = &organizationResource{}
+	_ resource.ResourceWithImportState = &organizationResource{}
+)
+
+// NewOrganizationResource is a helper function to simplify the provider implementation.
+func NewOrganizationResource() resource.Resource {
+	return &organizationResource{}
+}
+
+// organizationResource is the resource implementation.
+type organizationResource struct {
+	client *client.Client
+}
+
+// organizationResourceModel maps the resource schema data.
+type organizationResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	DefaultTimeZone types.String `tfsdk:"default_time_zone"`
+	DefaultRegions  types.Set    `tfsdk:"default_regions"`
+	BillingEmail    types.String `tfsdk:"billing_email"`
+	Tier            types.String `tfsdk:"tier"`
+}
+
+// Metadata returns the resource type name.
+func (r *organizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+// Schema defines the schema for the resource.
+func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages settings of the organization the provider's API key belongs to. " +
+			"This is a singleton: destroying it leaves the organization and its current settings in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Organization ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Organization name.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_time_zone": schema.StringAttribute{
+				Description: "Default IANA time zone (e.g. Europe/Berlin) used for schedules and reports.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					TimeZone(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_regions": schema.SetAttribute{
+				Description: "Default monitoring regions for checks created without explicit regions.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"billing_email": schema.StringAttribute{
+				Description: "Billing contact email address.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tier": schema.StringAttribute{
+				Description: "Subscription tier (e.g. free, paid, enterprise).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *organizationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create applies the configured settings and sets the initial Terraform state.
+func (r *organizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := r.buildUpdateRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org, err := r.client.UpdateOrganization(updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Organization",
+			"Could not apply organization settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapOrganizationToModel(ctx, org, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *organizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org, err := r.client.GetOrganization()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organization",
+			"Could not read organization: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapOrganizationToModel(ctx, org, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan organizationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := r.buildUpdateRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org, err := r.client.UpdateOrganization(updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Organization",
+			"Could not update organization settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapOrganizationToModel(ctx, org, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from Terraform state. The organization itself is
+// never deleted here; use quismon_signup.delete_organization_on_destroy for that.
+func (r *organizationResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports the resource state. Any ID may be used since the resource is a singleton.
+func (r *organizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// buildUpdateRequest converts the configured (known, non-null) settings into an API request.
+func (r *organizationResource) buildUpdateRequest(ctx context.Context, plan organizationResourceModel) (client.UpdateOrganizationRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	updateReq := client.UpdateOrganizationRequest{}
+
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		updateReq.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.DefaultTimeZone.IsNull() && !plan.DefaultTimeZone.IsUnknown() {
+		updateReq.DefaultTimeZone = plan.DefaultTimeZone.ValueStringPointer()
+	}
+	if !plan.DefaultRegions.IsNull() && !plan.DefaultRegions.IsUnknown() {
+		var regions []string
+		diags.Append(plan.DefaultRegions.ElementsAs(ctx, &regions, false)...)
+		updateReq.DefaultRegions = &regions
+	}
+	if !plan.BillingEmail.IsNull() && !plan.BillingEmail.IsUnknown() {
+		updateReq.BillingEmail = plan.BillingEmail.ValueStringPointer()
+	}
+
+	return updateReq, diags
+}
+
+// mapOrganizationToModel copies the API response into the model.
+func (r *organizationResource) mapOrganizationToModel(ctx context.Context, org *client.Organization, model *organizationResourceModel) diag.Diagnostics {
+	model.ID = types.StringValue(org.ID)
+	model.Name = types.StringValue(org.Name)
+	model.DefaultTimeZone = types.StringValue(org.DefaultTimeZone)
+	model.BillingEmail = types.StringValue(org.BillingEmail)
+	model.Tier = types.StringValue(org.Tier)
+
+	regions, diags := types.SetValueFrom(ctx, types.StringType, org.DefaultRegions)
+	model.DefaultRegions = regions
+	return diags
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationConfig("UTC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_organization.test", "default_time_zone", "UTC"),
+					resource.TestCheckResourceAttr("quismon_organization.test", "default_regions.#", "2"),
+					resource.TestCheckResourceAttrSet("quismon_organization.test", "id"),
+					resource.TestCheckResourceAttrSet("quismon_organization.test", "name"),
+					resource.TestCheckResourceAttrSet("quismon_organization.test", "tier"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quismon_organization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationConfig("Europe/Berlin"),
+				Check:  resource.TestCheckResourceAttr("quismon_organization.test", "default_time_zone", "Europe/Berlin"),
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfig(timeZone string) string {
+	return fmt.Sprintf(`
+resource "quismon_organization" "test" {
+  default_time_zone = %[1]q
+  default_regions   = ["na-east-ewr", "eu-central-fra"]
+}
+`, timeZone)
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+var _ datasource.DataSource = &privateLocationDataSource{}
+
+func NewPrivateLocationDataSource() datasource.DataSource {
+	return &privateLocationDataSource{}
+}
+
+type privateLocationDataSource struct {
+	client *client.Client
+}
+
+// privateLocationDataSourceModel maps the data source schema data
+type privateLocationDataSourceModel struct {
+	ID               types.String                `tfsdk:"id"`
+	Name             types.String                `tfsdk:"name"`
+	Description      types.String                `tfsdk:"description"`
+	Status           types.String                `tfsdk:"status"`
+	Healthy          types.Bool                  `tfsdk:"healthy"`
+	OnlineAgentCount types.Int64                 `tfsdk:"online_agent_count"`
+	Agents           []privateLocationAgentModel `tfsdk:"agents"`
+}
+
+// privateLocationAgentModel maps a single enrolled agent
+type privateLocationAgentModel struct {
+	ID         types.String `tfsdk:"id"`
+	Hostname   types.String `tfsdk:"hostname"`
+	Version    types.String `tfsdk:"version"`
+	Status     types.String `tfsdk:"status"`
+	LastSeenAt types.String `tfsdk:"last_seen_at"`
+}
+
+func (d *privateLocationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_location"
+}
+
+func (d *privateLocationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a private location and the health and version of its probe agents, e.g. to check that agents are running before adding the location to checks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Private location ID.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the location.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the location.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Location status: pending (no agent enrolled yet), online (all agents online), degraded (some agents offline), or offline.",
+				Computed:    true,
+			},
+			"healthy": schema.BoolAttribute{
+				Description: "Whether at least one agent is online, so checks in this location run.",
+				Computed:    true,
+			},
+			"online_agent_count": schema.Int64Attribute{
+				Description: "Number of online agents.",
+				Computed:    true,
+			},
+			"agents": schema.ListNestedAttribute{
+				Description: "Agents enrolled in the location.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Agent ID.",
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "Hostname the agent runs on.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Agent version.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Agent status: online, or offline if no heartbeat was received in the last 2 minutes.",
+							Computed:    true,
+						},
+						"last_seen_at": schema.StringAttribute{
+							Description: "Time of the last heartbeat.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *privateLocationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *privateLocationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data privateLocationDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	location, err := d.client.GetPrivateLocation(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Private Location", err.Error())
+		return
+	}
+
+	flattenPrivateLocation(location, &data)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// flattenPrivateLocation maps a private location and its agents into the data source model.
+func flattenPrivateLocation(location *client.PrivateLocation, data *privateLocationDataSourceModel) {
+	data.Name = types.StringValue(location.Name)
+	data.Description = optionalString(location.Description)
+	data.Status = types.StringValue(location.Status)
+
+	online := 0
+	data.Agents = []privateLocationAgentModel{}
+	for _, agent := range location.Agents {
+		if agent.Status == client.AgentStatusOnline {
+			online++
+		}
+		lastSeenAt := types.StringNull()
+		if agent.LastSeenAt != nil {
+			lastSeenAt = types.StringValue(*agent.LastSeenAt)
+		}
+		data.Agents = append(data.Agents, privateLocationAgentModel{
+			ID:         types.StringValue(agent.ID),
+			Hostname:   types.StringValue(agent.Hostname),
+			Version:    types.StringValue(agent.Version),
+			Status:     types.StringValue(agent.Status),
+			LastSeenAt: lastSeenAt,
+		})
+	}
+	data.OnlineAgentCount = types.Int64Value(int64(online))
+	data.Healthy = types.BoolValue(online > 0)
+}
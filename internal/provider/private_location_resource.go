@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &privateLocationResource{}
+	_ resource.ResourceWithConfigure   = &privateLocationResource{}
+	_ resource.ResourceWithImportState = &privateLocationResource{}
+	_ resource.ResourceWithModifyPlan  = &privateLocationResource{}
+)
+
+// NewPrivateLocationResource is a helper function to simplify the provider implementation.
+func NewPrivateLocationResource() resource.Resource {
+	return &privateLocationResource{}
+}
+
+// privateLocationResource is the resource implementation.
+type privateLocationResource struct {
+	client *client.Client
+}
+
+// privateLocationResourceModel maps the resource schema data.
+type privateLocationResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	OrgID           types.String `tfsdk:"org_id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	EnrollmentToken types.String `tfsdk:"enrollment_token"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *privateLocationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_location"
+}
+
+// Schema defines the schema for the resource.
+func (r *privateLocationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers a private location for self-hosted probe agents, e.g. to monitor internal services behind a VPN. " +
+			"Use the ID in the regions of quismon_check, and the quismon_private_location data source to read agent health. " +
+			"This is a PAID feature and requires a 'paid' or 'enterprise' tier subscription.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Private location ID. Use it like a region code in the regions of quismon_check.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "Organization ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the location, shown in check results (e.g., 'office-vpn').",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the location.",
+				Optional:    true,
+			},
+			"enrollment_token": schema.StringAttribute{
+				Description: "Token that probe agents use to enroll in this location (QUISMON_ENROLLMENT_TOKEN). " +
+					"Only returned on creation, so it is null after import.",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *privateLocationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan fails the plan early when the organization's tier does not include
+// private locations.
+func (r *privateLocationResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil || r.client.Organization == nil {
+		return
+	}
+
+	// Don't fail plans for an unchanged location, e.g. after a downgrade
+	if !req.State.Raw.IsNull() && resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	resp.Diagnostics.Append(checkPlanFeature(r.client.Organization, "private_locations", "Private locations", path.Root("name"))...)
+}
+
+// Create creates the resource and sets initial Terraform state.
+func (r *privateLocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan privateLocationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	location, err := r.client.CreatePrivateLocation(client.CreatePrivateLocationRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Private Location",
+			"Could not create private location, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(location.ID)
+	plan.OrgID = types.StringValue(location.OrgID)
+	plan.EnrollmentToken = optionalString(location.EnrollmentToken)
+	plan.CreatedAt = types.StringValue(location.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data. The enrollment token is
+// never returned after creation and is kept from state.
+func (r *privateLocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state privateLocationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	location, err := r.client.GetPrivateLocation(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Private Location",
+			"Could not read private location ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.OrgID = types.StringValue(location.OrgID)
+	state.Name = types.StringValue(location.Name)
+	state.Description = optionalString(location.Description)
+	state.CreatedAt = types.StringValue(location.CreatedAt)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *privateLocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan privateLocationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	description := plan.Description.ValueString()
+	_, err := r.client.UpdatePrivateLocation(plan.ID.ValueString(), client.UpdatePrivateLocationRequest{
+		Name:        &name,
+		Description: &description,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Private Location",
+			"Could not update private location, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *privateLocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state privateLocationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePrivateLocation(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Private Location",
+			"Could not delete private location, unexpected error: "+err.Error()+
+				". Remove the location from the regions of all checks first.",
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state. The enrollment token cannot be imported.
+func (r *privateLocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
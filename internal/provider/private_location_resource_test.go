@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+func TestFlattenPrivateLocation(t *testing.T) {
+	lastSeen := "2026-01-01T12:00:00Z"
+	location := &client.PrivateLocation{
+		ID:     "pl-1",
+		Name:   "office-vpn",
+		Status: "degraded",
+		Agents: []client.PrivateLocationAgent{
+			{ID: "agent-1", Hostname: "probe-1", Version: "1.4.0", Status: client.AgentStatusOnline, LastSeenAt: &lastSeen},
+			{ID: "agent-2", Hostname: "probe-2", Version: "1.3.2", Status: client.AgentStatusOffline},
+		},
+	}
+
+	var data privateLocationDataSourceModel
+	flattenPrivateLocation(location, &data)
+
+	if !data.Healthy.ValueBool() || data.OnlineAgentCount.ValueInt64() != 1 {
+		t.Errorf("expected a healthy location with 1 online agent, got %s and %s", data.Healthy, data.OnlineAgentCount)
+	}
+	if len(data.Agents) != 2 || data.Agents[1].Version.ValueString() != "1.3.2" {
+		t.Fatalf("unexpected agents %+v", data.Agents)
+	}
+	if data.Agents[0].LastSeenAt.ValueString() != lastSeen || !data.Agents[1].LastSeenAt.IsNull() {
+		t.Errorf("unexpected last_seen_at values %s and %s", data.Agents[0].LastSeenAt, data.Agents[1].LastSeenAt)
+	}
+	if !data.Description.IsNull() {
+		t.Errorf("expected a null description, got %s", data.Description)
+	}
+
+	// A location without agents is pending and not healthy
+	flattenPrivateLocation(&client.PrivateLocation{ID: "pl-2", Name: "new", Status: "pending"}, &data)
+	if data.Healthy.ValueBool() || data.Agents == nil || len(data.Agents) != 0 {
+		t.Errorf("expected an unhealthy location with an empty agent list, got %+v", data)
+	}
+}
+
+func TestPrivateLocationResource_ModifyPlanAfterDowngrade(t *testing.T) {
+	c, err := client.New("http://localhost", "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Organization = testTierOrganization()
+	h := newResourceHarness(t, NewPrivateLocationResource(), c)
+
+	model := privateLocationResourceModel{
+		ID:              types.StringValue("pl-1"),
+		OrgID:           types.StringValue("org-1"),
+		Name:            types.StringValue("office-vpn"),
+		Description:     types.StringNull(),
+		EnrollmentToken: types.StringValue("token"),
+		CreatedAt:       types.StringValue("2026-01-01T00:00:00Z"),
+	}
+	state := h.state(model)
+
+	if _, diags := h.modifyPlan(h.plan(model), state); diags.HasError() {
+		t.Errorf("expected no error for an unchanged location, got %v", diags)
+	}
+	model.Description = types.StringValue("Office network")
+	if _, diags := h.modifyPlan(h.plan(model), state); !diags.HasError() {
+		t.Error("expected a tier error for a changed location")
+	}
+}
+
+func TestAccPrivateLocationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "quismon_private_location" "test" {
+  name        = "tf-acc-private"
+  description = "Acceptance test location"
+}
+
+data "quismon_private_location" "test" {
+  id = quismon_private_location.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quismon_private_location.test", "id"),
+					resource.TestCheckResourceAttrSet("quismon_private_location.test", "enrollment_token"),
+					resource.TestCheckResourceAttr("data.quismon_private_location.test", "name", "tf-acc-private"),
+					resource.TestCheckResourceAttr("data.quismon_private_location.test", "status", "pending"),
+					resource.TestCheckResourceAttr("data.quismon_private_location.test", "healthy", "false"),
+					resource.TestCheckResourceAttr("data.quismon_private_location.test", "agents.#", "0"),
+				),
+			},
+			{
+				ResourceName:            "quismon_private_location.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"enrollment_token"},
+			},
+			{
+				Config: `
+resource "quismon_private_location" "test" {
+  name = "tf-acc-private-renamed"
+}
+
+resource "quismon_check" "internal" {
+  name             = "tf-acc-internal"
+  type             = "http"
+  interval_seconds = 60
+  regions          = [quismon_private_location.test.id]
+
+  config = {
+    url = "http://intranet.internal/healthz"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_private_location.test", "name", "tf-acc-private-renamed"),
+					resource.TestCheckResourceAttrPair("quismon_check.internal", "regions.0", "quismon_private_location.test", "id"),
+				),
+			},
+		},
+	})
+}
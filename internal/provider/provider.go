@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/quismon/terraform-provider-quismon/internal/client"
 )
 
@@ -148,6 +149,20 @@ func (p *quismonProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	// Fetch the organization's tier limits once so resources can validate
+	// plans against them. This is best-effort: during the signup bootstrap
+	// there is no key yet, and resources skip the checks when it is missing.
+	if apiKey != "" {
+		org, err := c.GetOrganization()
+		if err != nil {
+			tflog.Warn(ctx, "Could not fetch organization limits, plan-time tier checks are disabled", map[string]interface{}{
+				"error": err.Error(),
+			})
+		} else {
+			c.Organization = org
+		}
+	}
+
 	// Make the Quismon client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = c
@@ -161,6 +176,11 @@ func (p *quismonProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewChecksDataSource,
 		NewNotificationChannelDataSource,
 		NewRegionsDataSource,
+		NewOrganizationDataSource,
+		NewIncidentsDataSource,
+		NewSLODataSource,
+		NewCheckResultsDataSource,
+		NewPrivateLocationDataSource,
 	}
 }
 
@@ -172,5 +192,16 @@ func (p *quismonProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewNotificationChannelResource,
 		NewSignupResource,
 		NewOrganizationOTLPResource,
+		NewOrganizationPrometheusResource,
+		NewExportSinkResource,
+		NewPrivateLocationResource,
+		NewAPIKeyResource,
+		NewOrganizationResource,
+		NewTeamMemberResource,
+		NewTeamResource,
+		NewStatusPageResource,
+		NewStatusPageComponentResource,
+		NewIncidentResource,
+		NewSLOResource,
 	}
 }
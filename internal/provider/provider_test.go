@@ -119,7 +119,7 @@ func TestCheckResourceSchema_ComputedAttributes(t *testing.T) {
 	}
 
 	// These attributes must be computed (known after apply)
-	computedAttrs := []string{"id", "org_id", "health_status", "last_checked", "created_at", "updated_at"}
+	computedAttrs := []string{"id", "org_id", "ping_url", "paused", "health_status", "last_checked", "created_at", "updated_at"}
 
 	for _, attrName := range computedAttrs {
 		if _, ok := schemaResp.Schema.Attributes[attrName]; !ok {
@@ -182,7 +182,7 @@ func TestSignupSchema_ComputedAttributes(t *testing.T) {
 		t.Fatalf("Schema returned errors: %v", schemaResp.Diagnostics)
 	}
 
-	computedAttrs := []string{"id", "org_id", "api_key"}
+	computedAttrs := []string{"id", "org_id", "api_key", "verification_required", "email_verified", "tier"}
 
 	for _, attrName := range computedAttrs {
 		if _, ok := schemaResp.Schema.Attributes[attrName]; !ok {
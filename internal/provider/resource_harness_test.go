@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// resourceHarness calls a resource's methods the way Terraform does, so stand-in
+// tests exercise the real Create/Read/Update/Delete code without the Terraform CLI.
+// Plans, states and configs are built from resource models.
+type resourceHarness struct {
+	t        *testing.T
+	ctx      context.Context
+	resource resource.Resource
+	schema   resource.SchemaResponse
+}
+
+// newResourceHarness configures r with the given client.
+func newResourceHarness(t *testing.T, r resource.Resource, c *client.Client) *resourceHarness {
+	t.Helper()
+	h := &resourceHarness{t: t, ctx: context.Background(), resource: r}
+
+	r.Schema(h.ctx, resource.SchemaRequest{}, &h.schema)
+	if h.schema.Diagnostics.HasError() {
+		t.Fatalf("schema: %v", h.schema.Diagnostics)
+	}
+	if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+		var resp resource.ConfigureResponse
+		configurable.Configure(h.ctx, resource.ConfigureRequest{ProviderData: c}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("configure: %v", resp.Diagnostics)
+		}
+	}
+	return h
+}
+
+// emptyState returns a null state, as Terraform passes to Create.
+func (h *resourceHarness) emptyState() tfsdk.State {
+	return tfsdk.State{
+		Schema: h.schema.Schema,
+		Raw:    tftypes.NewValue(h.schema.Schema.Type().TerraformType(h.ctx), nil),
+	}
+}
+
+// state builds a state from a resource model.
+func (h *resourceHarness) state(model interface{}) tfsdk.State {
+	h.t.Helper()
+	state := h.emptyState()
+	if diags := state.Set(h.ctx, model); diags.HasError() {
+		h.t.Fatalf("building state: %v", diags)
+	}
+	return state
+}
+
+// plan builds a plan from a resource model.
+func (h *resourceHarness) plan(model interface{}) tfsdk.Plan {
+	h.t.Helper()
+	state := h.state(model)
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+// validateConfig runs ValidateConfig on a configuration built from a resource model.
+func (h *resourceHarness) validateConfig(model interface{}) diag.Diagnostics {
+	state := h.state(model)
+	var resp resource.ValidateConfigResponse
+	h.resource.(resource.ResourceWithValidateConfig).ValidateConfig(h.ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+	}, &resp)
+	return resp.Diagnostics
+}
+
+// modifyPlan runs ModifyPlan for a change from state to plan and returns the resulting plan.
+func (h *resourceHarness) modifyPlan(plan tfsdk.Plan, state tfsdk.State) (tfsdk.Plan, diag.Diagnostics) {
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	h.resource.(resource.ResourceWithModifyPlan).ModifyPlan(h.ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   plan,
+		State:  state,
+	}, &resp)
+	return resp.Plan, resp.Diagnostics
+}
+
+func (h *resourceHarness) create(plan tfsdk.Plan) (tfsdk.State, diag.Diagnostics) {
+	resp := resource.CreateResponse{State: h.emptyState()}
+	h.resource.Create(h.ctx, resource.CreateRequest{Plan: plan}, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+func (h *resourceHarness) read(state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	resp := resource.ReadResponse{State: state}
+	h.resource.Read(h.ctx, resource.ReadRequest{State: state}, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+func (h *resourceHarness) update(plan tfsdk.Plan, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	h.resource.Update(h.ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+func (h *resourceHarness) delete(state tfsdk.State) diag.Diagnostics {
+	resp := resource.DeleteResponse{State: state}
+	h.resource.Delete(h.ctx, resource.DeleteRequest{State: state}, &resp)
+	return resp.Diagnostics
+}
+
+// model reads a state back into a resource model.
+func (h *resourceHarness) model(state tfsdk.State, target interface{}) {
+	h.t.Helper()
+	if diags := state.Get(h.ctx, target); diags.HasError() {
+		h.t.Fatalf("reading state: %v", diags)
+	}
+}
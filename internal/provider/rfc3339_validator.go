@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// rfc3339Validator validates that a string attribute holds an RFC 3339 timestamp,
+// e.g. 2026-01-02T15:04:05Z.
+type rfc3339Validator struct{}
+
+// Description returns a human-readable description of the validator.
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp (e.g. 2026-01-02T15:04:05Z)"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v rfc3339Validator) MarkdownDescription(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp (e.g. `2026-01-02T15:04:05Z`)"
+}
+
+// ValidateString implements the validator interface.
+func (v rfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			"Expected an RFC 3339 timestamp (e.g. 2026-01-02T15:04:05Z), got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+// RFC3339Timestamp returns a validator which ensures a string is an RFC 3339 timestamp.
+func RFC3339Timestamp() validator.String {
+	return rfc3339Validator{}
+}
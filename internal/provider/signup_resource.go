@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,6 +18,7 @@ var (
 	_ resource.Resource                = &signupResource{}
 	_ resource.ResourceWithConfigure   = &signupResource{}
 	_ resource.ResourceWithImportState = &signupResource{}
+	_ resource.ResourceWithModifyPlan  = &signupResource{}
 )
 
 func NewSignupResource() resource.Resource {
@@ -28,12 +30,15 @@ type signupResource struct {
 }
 
 type signupResourceModel struct {
-	ID                   types.String `tfsdk:"id"`
-	Email                types.String `tfsdk:"email"`
-	OrgName              types.String `tfsdk:"org_name"`
-	OrgID                types.String `tfsdk:"org_id"`
-	APIKey               types.String `tfsdk:"api_key"`
-	VerificationRequired types.Bool   `tfsdk:"verification_required"`
+	ID                          types.String `tfsdk:"id"`
+	Email                       types.String `tfsdk:"email"`
+	OrgName                     types.String `tfsdk:"org_name"`
+	OrgID                       types.String `tfsdk:"org_id"`
+	APIKey                      types.String `tfsdk:"api_key"`
+	VerificationRequired        types.Bool   `tfsdk:"verification_required"`
+	EmailVerified               types.Bool   `tfsdk:"email_verified"`
+	Tier                        types.String `tfsdk:"tier"`
+	DeleteOrganizationOnDestroy types.Bool   `tfsdk:"delete_organization_on_destroy"`
 }
 
 func (r *signupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,27 +57,48 @@ func (r *signupResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"email": schema.StringAttribute{
-				Description: "Email address for the organization.",
+				Description: "Email address for the organization. Cannot be changed after signup.",
 				Required:    true,
 			},
 			"org_name": schema.StringAttribute{
-				Description: "Name for the organization. Defaults to 'My Organization'.",
+				Description: "Name for the organization. Defaults to 'My Organization'. Changing this renames the organization.",
 				Optional:    true,
 				Computed:    true,
 			},
 			"org_id": schema.StringAttribute{
 				Description: "The ID of the created organization.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"api_key": schema.StringAttribute{
 				Description: "The API key for the organization. Use this for subsequent resource creation.",
 				Computed:    true,
 				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"verification_required": schema.BoolAttribute{
-				Description: "Whether email verification is required before checks can run.",
+				Description: "Whether email verification is still required before checks can run. Refreshed on every read.",
+				Computed:    true,
+			},
+			"email_verified": schema.BoolAttribute{
+				Description: "Whether the organization's email address has been verified.",
+				Computed:    true,
+			},
+			"tier": schema.StringAttribute{
+				Description: "Subscription tier of the organization (e.g. free, paid, enterprise).",
 				Computed:    true,
 			},
+			"delete_organization_on_destroy": schema.BoolAttribute{
+				Description: "If true, destroying this resource permanently deletes the organization and everything in it. " +
+					"Useful for ephemeral CI environments. Default is false, which leaves the organization in place.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -94,6 +120,35 @@ func (r *signupResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.baseURL = client.BaseURL
 }
 
+// ModifyPlan rejects email changes: the API cannot change the email of an
+// organization, and replacing the signup would orphan the existing organization.
+func (r *signupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planEmail, stateEmail, orgID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("email"), &planEmail)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("email"), &stateEmail)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("org_id"), &orgID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported signups have no email in state yet and take the configured one
+	if stateEmail.IsNull() || planEmail.IsUnknown() || planEmail.Equal(stateEmail) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("email"),
+		"Email Cannot Be Changed",
+		fmt.Sprintf("Organization %s was signed up with %q, which cannot be changed. "+
+			"To sign up a new organization instead, replace this resource explicitly with terraform apply -replace.",
+			orgID.ValueString(), stateEmail.ValueString()),
+	)
+}
+
 func (r *signupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan signupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -120,6 +175,22 @@ func (r *signupResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.OrgID = types.StringValue(signupResp.OrgID)
 	plan.APIKey = types.StringValue(signupResp.APIKey)
 	plan.VerificationRequired = types.BoolValue(signupResp.VerificationRequired)
+	plan.EmailVerified = types.BoolNull()
+	plan.Tier = types.StringNull()
+
+	// Fill in the org name and tier chosen by the API
+	org, err := r.orgClient(plan.APIKey.ValueString()).GetOrganization()
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read Organization",
+			"The organization was created but its details could not be read yet: "+err.Error(),
+		)
+	} else {
+		mapOrganizationToSignupModel(org, &plan)
+	}
+	if plan.OrgName.IsUnknown() {
+		plan.OrgName = types.StringNull()
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -133,8 +204,29 @@ func (r *signupResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// Signup resources are not readable after creation
-	// Just return the current state
+	// Imported signups have no API key, so there is nothing to refresh with
+	if state.APIKey.IsNull() || state.APIKey.ValueString() == "" {
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	org, err := r.orgClient(state.APIKey.ValueString()).GetOrganization()
+	if client.IsNotFound(err) {
+		// The organization was deleted outside Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organization",
+			"Could not read organization "+state.OrgID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapOrganizationToSignupModel(org, &state)
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -147,8 +239,59 @@ func (r *signupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Signup resources cannot be updated
-	// Just return the current state
+	var state signupResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the organization name can be changed; ModifyPlan rejects email changes
+	rename := !plan.OrgName.IsUnknown() && !plan.OrgName.IsNull() && !plan.OrgName.Equal(state.OrgName)
+
+	// Imported signups have no API key, so only settings kept in state can change
+	if state.APIKey.IsNull() || state.APIKey.ValueString() == "" {
+		if rename {
+			resp.Diagnostics.AddError(
+				"Error Renaming Organization",
+				"Cannot rename organization "+state.OrgID.ValueString()+" because its API key is not in state (was it imported?). "+
+					"Rename it via the API or dashboard instead.",
+			)
+			return
+		}
+		plan.OrgName = state.OrgName
+		plan.VerificationRequired = state.VerificationRequired
+		plan.EmailVerified = state.EmailVerified
+		plan.Tier = state.Tier
+
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	orgClient := r.orgClient(state.APIKey.ValueString())
+	if rename {
+		name := plan.OrgName.ValueString()
+		if _, err := orgClient.UpdateOrganization(client.UpdateOrganizationRequest{Name: &name}); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Renaming Organization",
+				"Could not rename organization "+state.OrgID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	org, err := orgClient.GetOrganization()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organization",
+			"Could not read organization "+state.OrgID.ValueString()+" after update: "+err.Error(),
+		)
+		return
+	}
+
+	mapOrganizationToSignupModel(org, &plan)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -161,10 +304,47 @@ func (r *signupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	// Note: Deleting a signup resource does not delete the organization
-	// The organization must be deleted via the API if needed
+	// By default, deleting a signup resource does not delete the organization
+	if !state.DeleteOrganizationOnDestroy.ValueBool() {
+		return
+	}
+
+	if state.APIKey.IsNull() || state.APIKey.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Error Deleting Organization",
+			"Cannot delete organization "+state.OrgID.ValueString()+" because its API key is not in state (was it imported?). "+
+				"Delete it via the API or dashboard instead.",
+		)
+		return
+	}
+
+	if err := r.orgClient(state.APIKey.ValueString()).DeleteOrganization(); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Organization",
+			"Could not delete organization "+state.OrgID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
 }
 
 func (r *signupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// orgClient returns a client authenticated as the signed-up organization.
+// The provider-level API key may belong to a different organization (or be
+// empty during bootstrap), so the signup's own key is always used.
+func (r *signupResource) orgClient(apiKey string) *client.Client {
+	// client.New only fails on an empty base URL, which Configure always sets
+	c, _ := client.New(r.baseURL, apiKey)
+	return c
+}
+
+// mapOrganizationToSignupModel copies refreshed organization details into the model.
+func mapOrganizationToSignupModel(org *client.Organization, model *signupResourceModel) {
+	model.OrgID = types.StringValue(org.ID)
+	model.OrgName = types.StringValue(org.Name)
+	model.VerificationRequired = types.BoolValue(org.VerificationRequired)
+	model.EmailVerified = types.BoolValue(org.EmailVerified)
+	model.Tier = types.StringValue(org.Tier)
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+func testSignupModel() signupResourceModel {
+	return signupResourceModel{
+		ID:                          types.StringValue("org-1"),
+		Email:                       types.StringValue("ops@example.com"),
+		OrgName:                     types.StringValue("Example"),
+		OrgID:                       types.StringValue("org-1"),
+		APIKey:                      types.StringValue("org-key"),
+		VerificationRequired:        types.BoolValue(false),
+		EmailVerified:               types.BoolValue(true),
+		Tier:                        types.StringValue("free"),
+		DeleteOrganizationOnDestroy: types.BoolValue(false),
+	}
+}
+
+func TestSignupResource_ReadDeletedOrganization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"organization not found"}`, http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newResourceHarness(t, NewSignupResource(), c)
+
+	state, diags := h.read(h.state(testSignupModel()))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !state.Raw.IsNull() {
+		t.Error("expected the deleted organization to be removed from state")
+	}
+}
+
+func TestSignupResource_ModifyPlanEmail(t *testing.T) {
+	c, err := client.New("http://localhost", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newResourceHarness(t, NewSignupResource(), c)
+
+	model := testSignupModel()
+	state := h.state(model)
+
+	model.OrgName = types.StringValue("Renamed")
+	if _, diags := h.modifyPlan(h.plan(model), state); diags.HasError() {
+		t.Errorf("expected a rename to plan, got %v", diags)
+	}
+
+	model.Email = types.StringValue("new@example.com")
+	_, diags := h.modifyPlan(h.plan(model), state)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Email Cannot Be Changed" {
+		t.Errorf("expected an email change to be rejected, got %v", diags)
+	}
+
+	// Imported signups take the configured email
+	imported := testSignupModel()
+	imported.Email = types.StringNull()
+	if _, diags := h.modifyPlan(h.plan(model), h.state(imported)); diags.HasError() {
+		t.Errorf("expected the email of an imported signup to be accepted, got %v", diags)
+	}
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+var _ datasource.DataSource = &sloDataSource{}
+
+func NewSLODataSource() datasource.DataSource {
+	return &sloDataSource{}
+}
+
+type sloDataSource struct {
+	client *client.Client
+}
+
+// sloDataSourceModel maps the data source schema data
+type sloDataSourceModel struct {
+	ID                          types.String  `tfsdk:"id"`
+	Name                        types.String  `tfsdk:"name"`
+	Target                      types.Float64 `tfsdk:"target"`
+	SLI                         types.String  `tfsdk:"sli"`
+	Attainment                  types.Float64 `tfsdk:"attainment"`
+	ErrorBudgetRemaining        types.Float64 `tfsdk:"error_budget_remaining"`
+	ErrorBudgetRemainingMinutes types.Float64 `tfsdk:"error_budget_remaining_minutes"`
+	ErrorBudgetExhausted        types.Bool    `tfsdk:"error_budget_exhausted"`
+	BurnRate                    types.Float64 `tfsdk:"burn_rate"`
+	WindowStart                 types.String  `tfsdk:"window_start"`
+	WindowEnd                   types.String  `tfsdk:"window_end"`
+}
+
+func (d *sloDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slo"
+}
+
+func (d *sloDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the current attainment and remaining error budget of a Quismon SLO, e.g. to gate deployments.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "SLO ID.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "SLO name.",
+				Computed:    true,
+			},
+			"target": schema.Float64Attribute{
+				Description: "Target percentage of good events.",
+				Computed:    true,
+			},
+			"sli": schema.StringAttribute{
+				Description: "Service level indicator: availability or latency.",
+				Computed:    true,
+			},
+			"attainment": schema.Float64Attribute{
+				Description: "Percentage of good events in the current window.",
+				Computed:    true,
+			},
+			"error_budget_remaining": schema.Float64Attribute{
+				Description: "Percentage of the error budget left in the current window. Negative when the budget is exhausted.",
+				Computed:    true,
+			},
+			"error_budget_remaining_minutes": schema.Float64Attribute{
+				Description: "Error budget left, expressed as minutes of full downtime.",
+				Computed:    true,
+			},
+			"error_budget_exhausted": schema.BoolAttribute{
+				Description: "Whether the error budget has been used up.",
+				Computed:    true,
+			},
+			"burn_rate": schema.Float64Attribute{
+				Description: "Current burn rate as a multiple of the sustainable rate (1 consumes the budget exactly by the end of the window).",
+				Computed:    true,
+			},
+			"window_start": schema.StringAttribute{
+				Description: "Start of the current window.",
+				Computed:    true,
+			},
+			"window_end": schema.StringAttribute{
+				Description: "End of the current window.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *sloDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *sloDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sloDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slo, err := d.client.GetSLO(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading SLO", err.Error())
+		return
+	}
+
+	status, err := d.client.GetSLOStatus(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading SLO Status", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(slo.Name)
+	data.Target = types.Float64Value(slo.Target)
+	data.SLI = types.StringValue(slo.SLI)
+	data.Attainment = types.Float64Value(status.Attainment)
+	data.ErrorBudgetRemaining = types.Float64Value(status.ErrorBudgetRemaining)
+	data.ErrorBudgetRemainingMinutes = types.Float64Value(status.ErrorBudgetRemainingMinutes)
+	data.ErrorBudgetExhausted = types.BoolValue(status.ErrorBudgetRemaining <= 0)
+	data.BurnRate = types.Float64Value(status.BurnRate)
+	data.WindowStart = types.StringValue(status.WindowStart)
+	data.WindowEnd = types.StringValue(status.WindowEnd)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,444 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &sloResource{}
+	_ resource.ResourceWithConfigure      = &sloResource{}
+	_ resource.ResourceWithImportState    = &sloResource{}
+	_ resource.ResourceWithValidateConfig = &sloResource{}
+)
+
+// NewSLOResource is a helper function to simplify the provider implementation.
+func NewSLOResource() resource.Resource {
+	return &sloResource{}
+}
+
+// sloResource is the resource implementation.
+type sloResource struct {
+	client *client.Client
+}
+
+// sloResourceModel maps the resource schema data.
+type sloResourceModel struct {
+	ID                 types.String            `tfsdk:"id"`
+	OrgID              types.String            `tfsdk:"org_id"`
+	Name               types.String            `tfsdk:"name"`
+	Description        types.String            `tfsdk:"description"`
+	Target             types.Float64           `tfsdk:"target"`
+	Window             types.String            `tfsdk:"window"`
+	WindowDays         types.Int64             `tfsdk:"window_days"`
+	CalendarPeriod     types.String            `tfsdk:"calendar_period"`
+	CheckIDs           types.Set               `tfsdk:"check_ids"`
+	SLI                types.String            `tfsdk:"sli"`
+	LatencyThresholdMs types.Int64             `tfsdk:"latency_threshold_ms"`
+	BurnRateAlerts     []sloBurnRateAlertModel `tfsdk:"burn_rate_alerts"`
+	CreatedAt          types.String            `tfsdk:"created_at"`
+	UpdatedAt          types.String            `tfsdk:"updated_at"`
+}
+
+// sloBurnRateAlertModel maps a burn rate alert.
+type sloBurnRateAlertModel struct {
+	Threshold              types.Float64 `tfsdk:"threshold"`
+	WindowMinutes          types.Int64   `tfsdk:"window_minutes"`
+	NotificationChannelIDs types.Set     `tfsdk:"notification_channel_ids"`
+}
+
+// Metadata returns the resource type name.
+func (r *sloResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slo"
+}
+
+// Schema defines the schema for the resource.
+func (r *sloResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Quismon service level objective (SLO) computed from the results of one or more checks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "SLO ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "Organization ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "SLO name.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "SLO description.",
+				Optional:    true,
+			},
+			"target": schema.Float64Attribute{
+				Description: "Target percentage of good events, e.g. 99.9. The error budget is the remaining 100 - target percent.",
+				Required:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(1, 99.999),
+				},
+			},
+			"window": schema.StringAttribute{
+				Description: "Window type: rolling (the last window_days days) or calendar (the current calendar_period). Default is rolling.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("rolling"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("rolling", "calendar"),
+				},
+			},
+			"window_days": schema.Int64Attribute{
+				Description: "Length of a rolling window in days (1-90). Required when window is rolling.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 90),
+				},
+			},
+			"calendar_period": schema.StringAttribute{
+				Description: "Calendar period: week, month, or quarter. Required when window is calendar.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("week", "month", "quarter"),
+				},
+			},
+			"check_ids": schema.SetAttribute{
+				Description: "IDs of the checks whose results count towards the SLO.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"sli": schema.StringAttribute{
+				Description: "Service level indicator: availability (a run is good when the check passes) or latency " +
+					"(a run is good when it passes within latency_threshold_ms). Default is availability.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("availability"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("availability", "latency"),
+				},
+			},
+			"latency_threshold_ms": schema.Int64Attribute{
+				Description: "Response time in milliseconds under which a run counts as good. Required when sli is latency.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"burn_rate_alerts": schema.ListNestedAttribute{
+				Description: "Alerts sent when the error budget is consumed faster than a multiple of the sustainable rate.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"threshold": schema.Float64Attribute{
+							Description: "Burn rate multiple that triggers the alert, e.g. 14.4 (2% of a 30-day budget in one hour).",
+							Required:    true,
+							Validators: []validator.Float64{
+								float64validator.AtLeast(1),
+							},
+						},
+						"window_minutes": schema.Int64Attribute{
+							Description: "Lookback window in minutes over which the burn rate is measured (5-4320).",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(5, 4320),
+							},
+						},
+						"notification_channel_ids": schema.SetAttribute{
+							Description: "IDs of the notification channels to alert.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the window and SLI settings are consistent.
+func (r *sloResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sloResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Window.IsUnknown() {
+		rolling := config.Window.IsNull() || config.Window.ValueString() == "rolling"
+		requireSLOAttribute(&resp.Diagnostics, "window_days", config.WindowDays.IsNull(), rolling, "window is \"rolling\"")
+		requireSLOAttribute(&resp.Diagnostics, "calendar_period", config.CalendarPeriod.IsNull(), !rolling, "window is \"calendar\"")
+	}
+
+	if !config.SLI.IsUnknown() {
+		latency := config.SLI.ValueString() == "latency"
+		requireSLOAttribute(&resp.Diagnostics, "latency_threshold_ms", config.LatencyThresholdMs.IsNull(), latency, "sli is \"latency\"")
+	}
+}
+
+// requireSLOAttribute adds an error when an attribute is missing although the condition
+// holds, or set although it does not.
+func requireSLOAttribute(diags *diag.Diagnostics, attr string, isNull, condition bool, conditionText string) {
+	if condition && isNull {
+		diags.AddAttributeError(
+			path.Root(attr),
+			"Missing SLO Attribute",
+			fmt.Sprintf("%s must be set when %s.", attr, conditionText),
+		)
+	}
+	if !condition && !isNull {
+		diags.AddAttributeError(
+			path.Root(attr),
+			"Unexpected SLO Attribute",
+			fmt.Sprintf("%s can only be set when %s.", attr, conditionText),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sloResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *sloResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sloResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var checkIDs []string
+	diags = plan.CheckIDs.ElementsAs(ctx, &checkIDs, false)
+	resp.Diagnostics.Append(diags...)
+	alerts, diags := expandSLOBurnRateAlerts(ctx, plan.BurnRateAlerts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slo, err := r.client.CreateSLO(client.CreateSLORequest{
+		Name:               plan.Name.ValueString(),
+		Description:        plan.Description.ValueString(),
+		Target:             plan.Target.ValueFloat64(),
+		Window:             plan.Window.ValueString(),
+		WindowDays:         int(plan.WindowDays.ValueInt64()),
+		CalendarPeriod:     plan.CalendarPeriod.ValueString(),
+		CheckIDs:           checkIDs,
+		SLI:                plan.SLI.ValueString(),
+		LatencyThresholdMs: int(plan.LatencyThresholdMs.ValueInt64()),
+		BurnRateAlerts:     alerts,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SLO",
+			"Could not create SLO, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(slo.ID)
+	plan.OrgID = types.StringValue(slo.OrgID)
+	plan.CreatedAt = types.StringValue(slo.CreatedAt)
+	plan.UpdatedAt = types.StringValue(slo.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sloResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sloResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slo, err := r.client.GetSLO(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SLO",
+			"Could not read SLO ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.OrgID = types.StringValue(slo.OrgID)
+	state.Name = types.StringValue(slo.Name)
+	state.Description = optionalString(slo.Description)
+	state.Target = types.Float64Value(slo.Target)
+	state.Window = types.StringValue(slo.Window)
+	state.WindowDays = optionalInt64(slo.WindowDays)
+	state.CalendarPeriod = optionalString(slo.CalendarPeriod)
+	checkIDs, diags := types.SetValueFrom(ctx, types.StringType, slo.CheckIDs)
+	resp.Diagnostics.Append(diags...)
+	state.CheckIDs = checkIDs
+	state.SLI = types.StringValue(slo.SLI)
+	state.LatencyThresholdMs = optionalInt64(slo.LatencyThresholdMs)
+	state.BurnRateAlerts = nil
+	for _, alert := range slo.BurnRateAlerts {
+		channelIDs, diags := types.SetValueFrom(ctx, types.StringType, alert.NotificationChannelIDs)
+		resp.Diagnostics.Append(diags...)
+		state.BurnRateAlerts = append(state.BurnRateAlerts, sloBurnRateAlertModel{
+			Threshold:              types.Float64Value(alert.Threshold),
+			WindowMinutes:          types.Int64Value(int64(alert.WindowMinutes)),
+			NotificationChannelIDs: channelIDs,
+		})
+	}
+	state.CreatedAt = types.StringValue(slo.CreatedAt)
+	state.UpdatedAt = types.StringValue(slo.UpdatedAt)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *sloResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sloResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var checkIDs []string
+	diags = plan.CheckIDs.ElementsAs(ctx, &checkIDs, false)
+	resp.Diagnostics.Append(diags...)
+	alerts, diags := expandSLOBurnRateAlerts(ctx, plan.BurnRateAlerts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	description := plan.Description.ValueString()
+	target := plan.Target.ValueFloat64()
+	window := plan.Window.ValueString()
+	windowDays := int(plan.WindowDays.ValueInt64())
+	calendarPeriod := plan.CalendarPeriod.ValueString()
+	sli := plan.SLI.ValueString()
+	latencyThreshold := int(plan.LatencyThresholdMs.ValueInt64())
+	slo, err := r.client.UpdateSLO(plan.ID.ValueString(), client.UpdateSLORequest{
+		Name:               &name,
+		Description:        &description,
+		Target:             &target,
+		Window:             &window,
+		WindowDays:         &windowDays,
+		CalendarPeriod:     &calendarPeriod,
+		CheckIDs:           &checkIDs,
+		SLI:                &sli,
+		LatencyThresholdMs: &latencyThreshold,
+		BurnRateAlerts:     &alerts,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SLO",
+			"Could not update SLO, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.OrgID = types.StringValue(slo.OrgID)
+	plan.CreatedAt = types.StringValue(slo.CreatedAt)
+	plan.UpdatedAt = types.StringValue(slo.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *sloResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sloResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSLO(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SLO",
+			"Could not delete SLO, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *sloResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandSLOBurnRateAlerts converts the burn rate alert models into their API representation.
+func expandSLOBurnRateAlerts(ctx context.Context, models []sloBurnRateAlertModel) ([]client.SLOBurnRateAlert, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	alerts := []client.SLOBurnRateAlert{}
+	for _, model := range models {
+		var channelIDs []string
+		diags.Append(model.NotificationChannelIDs.ElementsAs(ctx, &channelIDs, false)...)
+		alerts = append(alerts, client.SLOBurnRateAlert{
+			Threshold:              model.Threshold.ValueFloat64(),
+			WindowMinutes:          int(model.WindowMinutes.ValueInt64()),
+			NotificationChannelIDs: channelIDs,
+		})
+	}
+	return alerts, diags
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSLOResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSLOConfig("99.9"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_slo.test", "name", "API availability"),
+					resource.TestCheckResourceAttr("quismon_slo.test", "target", "99.9"),
+					resource.TestCheckResourceAttr("quismon_slo.test", "window", "rolling"),
+					resource.TestCheckResourceAttr("quismon_slo.test", "window_days", "30"),
+					resource.TestCheckResourceAttr("quismon_slo.test", "sli", "availability"),
+					resource.TestCheckResourceAttr("quismon_slo.test", "burn_rate_alerts.#", "1"),
+					resource.TestCheckResourceAttr("quismon_slo.test", "burn_rate_alerts.0.threshold", "14.4"),
+					resource.TestCheckResourceAttrSet("data.quismon_slo.test", "error_budget_remaining"),
+					resource.TestCheckResourceAttrSet("data.quismon_slo.test", "error_budget_exhausted"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quismon_slo.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSLOConfig("99.5"),
+				Check:  resource.TestCheckResourceAttr("quismon_slo.test", "target", "99.5"),
+			},
+		},
+	})
+}
+
+func TestAccSLOResource_LatencyThresholdRequired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "quismon_slo" "test" {
+  name        = "API latency"
+  target      = 99
+  window_days = 7
+  check_ids   = ["00000000-0000-0000-0000-000000000000"]
+  sli         = "latency"
+}
+`,
+				ExpectError: regexp.MustCompile(`latency_threshold_ms must be set`),
+			},
+		},
+	})
+}
+
+func testAccSLOConfig(target string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "test" {
+  name             = "tf-acc-slo-check"
+  type             = "https"
+  interval_seconds = 300
+  regions          = ["na-east-ewr"]
+  config = {
+    url = "https://api.example.com/health"
+  }
+}
+
+resource "quismon_notification_channel" "test" {
+  name    = "tf-acc-slo-channel"
+  type    = "email"
+  enabled = true
+
+  config = {
+    to = jsonencode(["oncall@example.com"])
+  }
+}
+
+resource "quismon_slo" "test" {
+  name        = "API availability"
+  target      = %[1]s
+  window_days = 30
+  check_ids   = [quismon_check.test.id]
+
+  burn_rate_alerts = [
+    {
+      threshold                = 14.4
+      window_minutes           = 60
+      notification_channel_ids = [quismon_notification_channel.test.id]
+    },
+  ]
+}
+
+data "quismon_slo" "test" {
+  id = quismon_slo.test.id
+}
+`, target)
+}
@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// statusPageAggregations lists the rules for deriving a component's status from its checks.
+var statusPageAggregations = []string{"any_down", "majority_down", "weighted"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &statusPageComponentResource{}
+	_ resource.ResourceWithConfigure      = &statusPageComponentResource{}
+	_ resource.ResourceWithImportState    = &statusPageComponentResource{}
+	_ resource.ResourceWithValidateConfig = &statusPageComponentResource{}
+)
+
+// NewStatusPageComponentResource is a helper function to simplify the provider implementation.
+func NewStatusPageComponentResource() resource.Resource {
+	return &statusPageComponentResource{}
+}
+
+// statusPageComponentResource is the resource implementation.
+type statusPageComponentResource struct {
+	client *client.Client
+}
+
+// statusPageComponentResourceModel maps the resource schema data.
+type statusPageComponentResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	StatusPageID types.String `tfsdk:"status_page_id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	CheckIDs     types.Set    `tfsdk:"check_ids"`
+	Aggregation  types.String `tfsdk:"aggregation"`
+	Weights      types.Map    `tfsdk:"weights"`
+	DisplayOrder types.Int64  `tfsdk:"display_order"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *statusPageComponentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_component"
+}
+
+// Schema defines the schema for the resource.
+func (r *statusPageComponentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a component of a Quismon status page. A component groups several checks into one customer-facing entry " +
+			"(e.g. \"API\") so internal check names are never shown. Set grouping = \"components\" on the status page to display components.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Component ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page_id": schema.StringAttribute{
+				Description: "ID of the status page. Changing this will force recreation of the component.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Public name of the component.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Public description of the component.",
+				Optional:    true,
+			},
+			"check_ids": schema.SetAttribute{
+				Description: "IDs of the checks that make up the component.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"aggregation": schema.StringAttribute{
+				Description: "How the component status is derived from its checks: any_down (down when any check is down), " +
+					"majority_down (down when more than half of the checks are down) or weighted (down when checks carrying at least half of the total weight are down). " +
+					"Checks that are down but not enough to mark the component down show it as degraded. Default is any_down.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("any_down"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(statusPageAggregations...),
+				},
+			},
+			"weights": schema.MapAttribute{
+				Description: "Weight of each check, keyed by check ID. Required when aggregation is weighted.",
+				Optional:    true,
+				ElementType: types.Float64Type,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"display_order": schema.Int64Attribute{
+				Description: "Position of the component on the page; lower values are shown first. Default is 0.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that weights are only used with, and complete for, weighted aggregation.
+func (r *statusPageComponentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config statusPageComponentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Aggregation.IsUnknown() || config.Weights.IsUnknown() {
+		return
+	}
+
+	weighted := config.Aggregation.ValueString() == "weighted"
+	if weighted && config.Weights.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("weights"),
+			"Missing Component Weights",
+			"weights must be set when aggregation is \"weighted\".",
+		)
+		return
+	}
+	if !weighted && !config.Weights.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("weights"),
+			"Unexpected Component Weights",
+			"weights can only be set when aggregation is \"weighted\".",
+		)
+		return
+	}
+	if !weighted || config.CheckIDs.IsNull() || config.CheckIDs.IsUnknown() {
+		return
+	}
+
+	var checkIDs []types.String
+	resp.Diagnostics.Append(config.CheckIDs.ElementsAs(ctx, &checkIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	weights := config.Weights.Elements()
+	for _, id := range checkIDs {
+		if id.IsUnknown() {
+			continue
+		}
+		if _, ok := weights[id.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("weights"),
+				"Missing Component Weight",
+				fmt.Sprintf("No weight is set for check %q. Every check in check_ids needs a weight.", id.ValueString()),
+			)
+		}
+	}
+	for key, value := range weights {
+		if f, ok := value.(types.Float64); ok && !f.IsNull() && !f.IsUnknown() && f.ValueFloat64() <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("weights").AtMapKey(key),
+				"Invalid Component Weight",
+				fmt.Sprintf("Weight for check %q must be greater than 0.", key),
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *statusPageComponentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *statusPageComponentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan statusPageComponentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var checkIDs []string
+	diags = plan.CheckIDs.ElementsAs(ctx, &checkIDs, false)
+	resp.Diagnostics.Append(diags...)
+	weights := map[string]float64{}
+	if !plan.Weights.IsNull() {
+		diags = plan.Weights.ElementsAs(ctx, &weights, false)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	component, err := r.client.CreateStatusPageComponent(plan.StatusPageID.ValueString(), client.CreateStatusPageComponentRequest{
+		Name:         plan.Name.ValueString(),
+		Description:  plan.Description.ValueString(),
+		CheckIDs:     checkIDs,
+		Aggregation:  plan.Aggregation.ValueString(),
+		Weights:      weights,
+		DisplayOrder: int(plan.DisplayOrder.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Status Page Component",
+			"Could not create status page component, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(component.ID)
+	plan.CreatedAt = types.StringValue(component.CreatedAt)
+	plan.UpdatedAt = types.StringValue(component.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *statusPageComponentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state statusPageComponentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	component, err := r.client.GetStatusPageComponent(state.StatusPageID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Status Page Component",
+			"Could not read status page component ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.StatusPageID = types.StringValue(component.StatusPageID)
+	state.Name = types.StringValue(component.Name)
+	if component.Description != "" {
+		state.Description = types.StringValue(component.Description)
+	} else {
+		state.Description = types.StringNull()
+	}
+	checkIDs, diags := types.SetValueFrom(ctx, types.StringType, component.CheckIDs)
+	resp.Diagnostics.Append(diags...)
+	state.CheckIDs = checkIDs
+	state.Aggregation = types.StringValue(component.Aggregation)
+	if len(component.Weights) > 0 {
+		weights, diags := types.MapValueFrom(ctx, types.Float64Type, component.Weights)
+		resp.Diagnostics.Append(diags...)
+		state.Weights = weights
+	} else {
+		state.Weights = types.MapNull(types.Float64Type)
+	}
+	state.DisplayOrder = types.Int64Value(int64(component.DisplayOrder))
+	state.CreatedAt = types.StringValue(component.CreatedAt)
+	state.UpdatedAt = types.StringValue(component.UpdatedAt)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *statusPageComponentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan statusPageComponentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var checkIDs []string
+	diags = plan.CheckIDs.ElementsAs(ctx, &checkIDs, false)
+	resp.Diagnostics.Append(diags...)
+	// An empty map clears weights left over from a previous weighted aggregation.
+	weights := map[string]float64{}
+	if !plan.Weights.IsNull() {
+		diags = plan.Weights.ElementsAs(ctx, &weights, false)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	description := plan.Description.ValueString()
+	aggregation := plan.Aggregation.ValueString()
+	displayOrder := int(plan.DisplayOrder.ValueInt64())
+	component, err := r.client.UpdateStatusPageComponent(plan.StatusPageID.ValueString(), plan.ID.ValueString(), client.UpdateStatusPageComponentRequest{
+		Name:         &name,
+		Description:  &description,
+		CheckIDs:     &checkIDs,
+		Aggregation:  &aggregation,
+		Weights:      &weights,
+		DisplayOrder: &displayOrder,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Status Page Component",
+			"Could not update status page component, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.CreatedAt = types.StringValue(component.CreatedAt)
+	plan.UpdatedAt = types.StringValue(component.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *statusPageComponentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state statusPageComponentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteStatusPageComponent(state.StatusPageID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Status Page Component",
+			"Could not delete status page component, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *statusPageComponentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: status_page_id:component_id
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected import ID in format: status_page_id:component_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -0,0 +1,502 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// statusPageGroupings lists the supported ways of grouping checks on a status page.
+var statusPageGroupings = []string{"flat", "check_type", "components"}
+
+var (
+	statusPageSlugRegexp  = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	statusPageColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &statusPageResource{}
+	_ resource.ResourceWithConfigure      = &statusPageResource{}
+	_ resource.ResourceWithImportState    = &statusPageResource{}
+	_ resource.ResourceWithModifyPlan     = &statusPageResource{}
+	_ resource.ResourceWithValidateConfig = &statusPageResource{}
+)
+
+// NewStatusPageResource is a helper function to simplify the provider implementation.
+func NewStatusPageResource() resource.Resource {
+	return &statusPageResource{}
+}
+
+// statusPageResource is the resource implementation.
+type statusPageResource struct {
+	client *client.Client
+}
+
+// statusPageResourceModel maps the resource schema data.
+type statusPageResourceModel struct {
+	ID                    types.String             `tfsdk:"id"`
+	OrgID                 types.String             `tfsdk:"org_id"`
+	Slug                  types.String             `tfsdk:"slug"`
+	Title                 types.String             `tfsdk:"title"`
+	Description           types.String             `tfsdk:"description"`
+	Grouping              types.String             `tfsdk:"grouping"`
+	Visibility            types.String             `tfsdk:"visibility"`
+	Password              types.String             `tfsdk:"password"`
+	PasswordHash          types.String             `tfsdk:"password_hash"`
+	Branding              *statusPageBrandingModel `tfsdk:"branding"`
+	CustomDomain          types.String             `tfsdk:"custom_domain"`
+	IncidentRetentionDays types.Int64              `tfsdk:"incident_retention_days"`
+	CheckIDs              types.Set                `tfsdk:"check_ids"`
+	URL                   types.String             `tfsdk:"url"`
+	CreatedAt             types.String             `tfsdk:"created_at"`
+	UpdatedAt             types.String             `tfsdk:"updated_at"`
+}
+
+// statusPageBrandingModel maps the nested branding object.
+type statusPageBrandingModel struct {
+	LogoURL    types.String `tfsdk:"logo_url"`
+	FaviconURL types.String `tfsdk:"favicon_url"`
+	BrandColor types.String `tfsdk:"brand_color"`
+}
+
+// Metadata returns the resource type name.
+func (r *statusPageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page"
+}
+
+// Schema defines the schema for the resource.
+func (r *statusPageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Quismon status page. An organization can have several pages, for example a public page and a password-protected partner page.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Status page ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "Organization ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"slug": schema.StringAttribute{
+				Description: "URL slug of the page, unique across Quismon. The page is served at https://status.quismon.com/{slug}.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+					stringvalidator.RegexMatches(statusPageSlugRegexp, "must contain only lowercase letters, digits, and hyphens, and must not start or end with a hyphen"),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Title shown at the top of the page.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Short description shown below the title.",
+				Optional:    true,
+			},
+			"grouping": schema.StringAttribute{
+				Description: "How checks are grouped on the page: flat (a single list), check_type (grouped by check type) or components (one entry per quismon_status_page_component). Default is flat.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("flat"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(statusPageGroupings...),
+				},
+			},
+			"visibility": schema.StringAttribute{
+				Description: "Who can view the page: public or password. Default is public.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("public"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("public", "password"),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "Password required to view the page. Required when visibility is password. " +
+					"The API only returns its hash; a password changed in the dashboard is reported via password_hash and set back on the next apply.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+				},
+			},
+			"password_hash": schema.StringAttribute{
+				Description: "Hash of the page password, used to detect changes made outside Terraform.",
+				Computed:    true,
+			},
+			"branding": schema.SingleNestedAttribute{
+				Description: "Visual customization of the page.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"logo_url": schema.StringAttribute{
+						Description: "URL of the logo shown in the page header.",
+						Optional:    true,
+					},
+					"favicon_url": schema.StringAttribute{
+						Description: "URL of the page favicon.",
+						Optional:    true,
+					},
+					"brand_color": schema.StringAttribute{
+						Description: "Accent color as a hex code (e.g. #1a73e8).",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(statusPageColorRegexp, "must be a hex color such as #1a73e8"),
+						},
+					},
+				},
+			},
+			"custom_domain": schema.StringAttribute{
+				Description: "Custom domain serving the page (e.g. status.example.com). Point a CNAME record at status.quismon.com before setting this.",
+				Optional:    true,
+			},
+			"incident_retention_days": schema.Int64Attribute{
+				Description: "Number of days resolved incidents remain visible on the page. Default is 90.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(90),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 365),
+				},
+			},
+			"check_ids": schema.SetAttribute{
+				Description: "IDs of the checks shown on the page.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL where the page is served.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures a password is configured for password-protected pages.
+func (r *statusPageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config statusPageResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Visibility.IsUnknown() || config.Password.IsUnknown() {
+		return
+	}
+
+	if config.Visibility.ValueString() == "password" && config.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing Status Page Password",
+			"password must be set when visibility is \"password\".",
+		)
+	}
+	if config.Visibility.ValueString() != "password" && !config.Password.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("password"),
+			"Status Page Password Ignored",
+			"password only takes effect when visibility is \"password\".",
+		)
+	}
+}
+
+// ModifyPlan keeps password_hash known while the password and visibility are unchanged.
+func (r *statusPageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planPassword, statePassword, planVisibility, stateVisibility, stateHash types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("password"), &planPassword)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password"), &statePassword)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("visibility"), &planVisibility)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("visibility"), &stateVisibility)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password_hash"), &stateHash)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planPassword.Equal(statePassword) && planVisibility.Equal(stateVisibility) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_hash"), stateHash)...)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *statusPageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *statusPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan statusPageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkIDs := []string{}
+	if !plan.CheckIDs.IsNull() {
+		diags = plan.CheckIDs.ElementsAs(ctx, &checkIDs, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	createReq := client.CreateStatusPageRequest{
+		Slug:                  plan.Slug.ValueString(),
+		Title:                 plan.Title.ValueString(),
+		Description:           plan.Description.ValueString(),
+		Grouping:              plan.Grouping.ValueString(),
+		Visibility:            plan.Visibility.ValueString(),
+		Branding:              expandStatusPageBranding(plan.Branding),
+		CustomDomain:          plan.CustomDomain.ValueString(),
+		IncidentRetentionDays: int(plan.IncidentRetentionDays.ValueInt64()),
+		CheckIDs:              checkIDs,
+	}
+	if !plan.Password.IsNull() {
+		createReq.Password = plan.Password.ValueStringPointer()
+	}
+
+	page, err := r.client.CreateStatusPage(createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Status Page",
+			"Could not create status page, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(page.ID)
+	mapStatusPageComputed(page, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *statusPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state statusPageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, err := r.client.GetStatusPage(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Status Page",
+			"Could not read status page ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Slug = types.StringValue(page.Slug)
+	state.Title = types.StringValue(page.Title)
+	if page.Description != "" {
+		state.Description = types.StringValue(page.Description)
+	} else {
+		state.Description = types.StringNull()
+	}
+	state.Grouping = types.StringValue(page.Grouping)
+	state.Visibility = types.StringValue(page.Visibility)
+	state.Branding = flattenStatusPageBranding(page.Branding)
+	if page.CustomDomain != "" {
+		state.CustomDomain = types.StringValue(page.CustomDomain)
+	} else {
+		state.CustomDomain = types.StringNull()
+	}
+	state.IncidentRetentionDays = types.Int64Value(int64(page.IncidentRetentionDays))
+
+	// The password is write-only: forget it when it was changed outside Terraform
+	// so that the next plan sets it again.
+	if secretHashChanged(state.PasswordHash, page.PasswordHash) {
+		resp.Diagnostics.AddWarning(
+			"Status Page Password Drift Detected",
+			"The password of status page "+page.Slug+" has been changed outside of Terraform. "+
+				"The password_hash changed from "+state.PasswordHash.ValueString()+" to "+page.PasswordHash+". "+
+				"The next apply resets it to your Terraform-defined password.",
+		)
+		state.Password = types.StringNull()
+	}
+	if len(page.CheckIDs) > 0 {
+		checkIDs, diags := types.SetValueFrom(ctx, types.StringType, page.CheckIDs)
+		resp.Diagnostics.Append(diags...)
+		state.CheckIDs = checkIDs
+	} else {
+		state.CheckIDs = types.SetNull(types.StringType)
+	}
+	mapStatusPageComputed(page, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *statusPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state statusPageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkIDs := []string{}
+	if !plan.CheckIDs.IsNull() {
+		diags = plan.CheckIDs.ElementsAs(ctx, &checkIDs, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	slug := plan.Slug.ValueString()
+	title := plan.Title.ValueString()
+	description := plan.Description.ValueString()
+	grouping := plan.Grouping.ValueString()
+	visibility := plan.Visibility.ValueString()
+	customDomain := plan.CustomDomain.ValueString()
+	retention := int(plan.IncidentRetentionDays.ValueInt64())
+	branding := expandStatusPageBranding(plan.Branding)
+	if branding == nil {
+		// Send an empty object so removed branding is cleared rather than left untouched.
+		branding = &client.StatusPageBranding{}
+	}
+
+	updateReq := client.UpdateStatusPageRequest{
+		Slug:                  &slug,
+		Title:                 &title,
+		Description:           &description,
+		Grouping:              &grouping,
+		Visibility:            &visibility,
+		Branding:              branding,
+		CustomDomain:          &customDomain,
+		IncidentRetentionDays: &retention,
+		CheckIDs:              &checkIDs,
+	}
+	// The password is write-only, so only send it when it changed.
+	if !plan.Password.IsNull() && !plan.Password.Equal(state.Password) {
+		updateReq.Password = plan.Password.ValueStringPointer()
+	}
+
+	page, err := r.client.UpdateStatusPage(plan.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Status Page",
+			"Could not update status page, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapStatusPageComputed(page, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *statusPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state statusPageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteStatusPage(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Status Page",
+			"Could not delete status page, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state. The password cannot be imported.
+func (r *statusPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapStatusPageComputed copies the computed fields of an API response into the model.
+func mapStatusPageComputed(page *client.StatusPage, model *statusPageResourceModel) {
+	model.OrgID = types.StringValue(page.OrgID)
+	model.URL = types.StringValue(page.URL)
+	model.PasswordHash = types.StringValue(page.PasswordHash)
+	model.CreatedAt = types.StringValue(page.CreatedAt)
+	model.UpdatedAt = types.StringValue(page.UpdatedAt)
+}
+
+// expandStatusPageBranding converts the branding model into its API representation.
+func expandStatusPageBranding(model *statusPageBrandingModel) *client.StatusPageBranding {
+	if model == nil {
+		return nil
+	}
+	return &client.StatusPageBranding{
+		LogoURL:    model.LogoURL.ValueString(),
+		FaviconURL: model.FaviconURL.ValueString(),
+		BrandColor: model.BrandColor.ValueString(),
+	}
+}
+
+// flattenStatusPageBranding converts API branding into the model, returning nil when nothing is set.
+func flattenStatusPageBranding(branding *client.StatusPageBranding) *statusPageBrandingModel {
+	if branding == nil || (branding.LogoURL == "" && branding.FaviconURL == "" && branding.BrandColor == "") {
+		return nil
+	}
+
+	return &statusPageBrandingModel{
+		LogoURL:    optionalString(branding.LogoURL),
+		FaviconURL: optionalString(branding.FaviconURL),
+		BrandColor: optionalString(branding.BrandColor),
+	}
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccStatusPageResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStatusPageConfig("Acme Status", "flat"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_status_page.test", "slug", "tf-acc-status"),
+					resource.TestCheckResourceAttr("quismon_status_page.test", "title", "Acme Status"),
+					resource.TestCheckResourceAttr("quismon_status_page.test", "grouping", "flat"),
+					resource.TestCheckResourceAttr("quismon_status_page.test", "visibility", "public"),
+					resource.TestCheckResourceAttr("quismon_status_page.test", "incident_retention_days", "30"),
+					resource.TestCheckResourceAttr("quismon_status_page.test", "branding.brand_color", "#1a73e8"),
+					resource.TestCheckResourceAttr("quismon_status_page.test", "check_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("quismon_status_page.test", "check_ids.0", "quismon_check.test", "id"),
+					resource.TestCheckResourceAttrSet("quismon_status_page.test", "url"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quismon_status_page.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccStatusPageConfig("Acme Platform Status", "check_type"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_status_page.test", "title", "Acme Platform Status"),
+					resource.TestCheckResourceAttr("quismon_status_page.test", "grouping", "check_type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStatusPageResource_PasswordRequired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "quismon_status_page" "test" {
+  slug       = "tf-acc-partners"
+  title      = "Partner Status"
+  visibility = "password"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing Status Page Password`),
+			},
+		},
+	})
+}
+
+func testAccStatusPageConfig(title, grouping string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "test" {
+  name             = "tf-acc-status-page-check"
+  type             = "https"
+  interval_seconds = 300
+  regions          = ["na-east-ewr"]
+  config = {
+    url = "https://example.com"
+  }
+}
+
+resource "quismon_status_page" "test" {
+  slug                    = "tf-acc-status"
+  title                   = %[1]q
+  grouping                = %[2]q
+  incident_retention_days = 30
+  check_ids               = [quismon_check.test.id]
+
+  branding = {
+    brand_color = "#1a73e8"
+  }
+}
+`, title, grouping)
+}
+
+func TestAccStatusPageComponentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStatusPageComponentConfig("any_down", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_status_page_component.api", "name", "API"),
+					resource.TestCheckResourceAttr("quismon_status_page_component.api", "aggregation", "any_down"),
+					resource.TestCheckResourceAttr("quismon_status_page_component.api", "display_order", "1"),
+					resource.TestCheckResourceAttr("quismon_status_page_component.api", "check_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("quismon_status_page_component.api", "status_page_id", "quismon_status_page.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quismon_status_page_component.api",
+				ImportState:       true,
+				ImportStateIdFunc: testAccStatusPageComponentImportID("quismon_status_page_component.api"),
+				ImportStateVerify: true,
+			},
+			// Switch to weighted aggregation
+			{
+				Config: testAccStatusPageComponentConfig("weighted", `
+  weights = {
+    (quismon_check.primary.id)   = 3
+    (quismon_check.secondary.id) = 1
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_status_page_component.api", "aggregation", "weighted"),
+					resource.TestCheckResourceAttr("quismon_status_page_component.api", "weights.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStatusPageComponentResource_WeightsRequired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "quismon_status_page_component" "test" {
+  status_page_id = "00000000-0000-0000-0000-000000000000"
+  name           = "API"
+  check_ids      = ["00000000-0000-0000-0000-000000000001"]
+  aggregation    = "weighted"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing Component Weights`),
+			},
+		},
+	})
+}
+
+func testAccStatusPageComponentImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["status_page_id"] + ":" + rs.Primary.ID, nil
+	}
+}
+
+func testAccStatusPageComponentConfig(aggregation, weights string) string {
+	return fmt.Sprintf(`
+resource "quismon_check" "primary" {
+  name             = "tf-acc-component-primary"
+  type             = "https"
+  interval_seconds = 300
+  regions          = ["na-east-ewr"]
+  config = {
+    url = "https://api.example.com/health"
+  }
+}
+
+resource "quismon_check" "secondary" {
+  name             = "tf-acc-component-secondary"
+  type             = "https"
+  interval_seconds = 300
+  regions          = ["na-east-ewr"]
+  config = {
+    url = "https://api-backup.example.com/health"
+  }
+}
+
+resource "quismon_status_page" "test" {
+  slug     = "tf-acc-components"
+  title    = "Component Status"
+  grouping = "components"
+}
+
+resource "quismon_status_page_component" "api" {
+  status_page_id = quismon_status_page.test.id
+  name           = "API"
+  description    = "Public REST API"
+  check_ids      = [quismon_check.primary.id, quismon_check.secondary.id]
+  aggregation    = %[1]q
+  display_order  = 1
+%[2]s
+}
+`, aggregation, weights)
+}
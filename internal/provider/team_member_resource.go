@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// teamMemberRoles lists the roles that can be assigned to a team member.
+var teamMemberRoles = []string{"owner", "admin", "editor", "viewer"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamMemberResource{}
+	_ resource.ResourceWithConfigure   = &teamMemberResource{}
+	_ resource.ResourceWithImportState = &teamMemberResource{}
+)
+
+// NewTeamMemberResource is a helper function to simplify the provider implementation.
+func NewTeamMemberResource() resource.Resource {
+	return &teamMemberResource{}
+}
+
+// teamMemberResource is the resource implementation.
+type teamMemberResource struct {
+	client *client.Client
+}
+
+// teamMemberResourceModel maps the resource schema data.
+type teamMemberResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	OrgID     types.String `tfsdk:"org_id"`
+	Email     types.String `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *teamMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a member of the Quismon organization. Creating the resource sends an invitation; destroying it removes the member's access.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Team member ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "Organization ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address of the member. Changing this will remove the member and invite the new address.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(3),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of the member: owner, admin, editor, or viewer.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(teamMemberRoles...),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Membership status: invited (invitation not yet accepted) or active.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create invites the member and sets the initial Terraform state.
+func (r *teamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.CreateTeamMember(client.CreateTeamMemberRequest{
+		Email: plan.Email.ValueString(),
+		Role:  plan.Role.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Team Member",
+			"Could not invite team member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(member.ID)
+	mapTeamMemberToModel(member, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.GetTeamMember(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Team Member",
+			"Could not read team member ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Email = types.StringValue(member.Email)
+	state.Role = types.StringValue(member.Role)
+	mapTeamMemberToModel(member, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update changes the member's role and sets the updated Terraform state on success.
+func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan teamMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role := plan.Role.ValueString()
+	member, err := r.client.UpdateTeamMember(plan.ID.ValueString(), client.UpdateTeamMemberRequest{Role: &role})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Team Member",
+			"Could not update team member role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapTeamMemberToModel(member, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the member from the organization.
+func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTeamMember(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Team Member",
+			"Could not remove team member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *teamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapTeamMemberToModel copies the computed fields of an API response into the model.
+func mapTeamMemberToModel(member *client.TeamMember, model *teamMemberResourceModel) {
+	model.OrgID = types.StringValue(member.OrgID)
+	model.Status = types.StringValue(member.Status)
+	model.CreatedAt = types.StringValue(member.CreatedAt)
+	model.UpdatedAt = types.StringValue(member.UpdatedAt)
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamResource{}
+	_ resource.ResourceWithConfigure   = &teamResource{}
+	_ resource.ResourceWithImportState = &teamResource{}
+)
+
+// NewTeamResource is a helper function to simplify the provider implementation.
+func NewTeamResource() resource.Resource {
+	return &teamResource{}
+}
+
+// teamResource is the resource implementation.
+type teamResource struct {
+	client *client.Client
+}
+
+// teamResourceModel maps the resource schema data.
+type teamResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	OrgID       types.String `tfsdk:"org_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	MemberIDs   types.Set    `tfsdk:"member_ids"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *teamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Quismon team, a named group of organization members.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Team ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "Organization ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Team name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Team description.",
+				Optional:    true,
+			},
+			"member_ids": schema.SetAttribute{
+				Description: "IDs of quismon_team_member resources that belong to the team.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberIDs := []string{}
+	if !plan.MemberIDs.IsNull() {
+		diags = plan.MemberIDs.ElementsAs(ctx, &memberIDs, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	team, err := r.client.CreateTeam(client.CreateTeamRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		MemberIDs:   memberIDs,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Team",
+			"Could not create team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(team.ID)
+	plan.OrgID = types.StringValue(team.OrgID)
+	plan.CreatedAt = types.StringValue(team.CreatedAt)
+	plan.UpdatedAt = types.StringValue(team.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := r.client.GetTeam(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Team",
+			"Could not read team ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.OrgID = types.StringValue(team.OrgID)
+	state.Name = types.StringValue(team.Name)
+	if team.Description != "" {
+		state.Description = types.StringValue(team.Description)
+	} else {
+		state.Description = types.StringNull()
+	}
+	if len(team.MemberIDs) > 0 {
+		memberIDs, diags := types.SetValueFrom(ctx, types.StringType, team.MemberIDs)
+		resp.Diagnostics.Append(diags...)
+		state.MemberIDs = memberIDs
+	} else {
+		state.MemberIDs = types.SetNull(types.StringType)
+	}
+	state.CreatedAt = types.StringValue(team.CreatedAt)
+	state.UpdatedAt = types.StringValue(team.UpdatedAt)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan teamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberIDs := []string{}
+	if !plan.MemberIDs.IsNull() {
+		diags = plan.MemberIDs.ElementsAs(ctx, &memberIDs, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	name := plan.Name.ValueString()
+	description := plan.Description.ValueString()
+	team, err := r.client.UpdateTeam(plan.ID.ValueString(), client.UpdateTeamRequest{
+		Name:        &name,
+		Description: &description,
+		MemberIDs:   &memberIDs,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Team",
+			"Could not update team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.OrgID = types.StringValue(team.OrgID)
+	plan.CreatedAt = types.StringValue(team.CreatedAt)
+	plan.UpdatedAt = types.StringValue(team.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTeam(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Team",
+			"Could not delete team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
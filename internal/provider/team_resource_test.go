@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamConfig("viewer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quismon_team_member.test", "email", "tf-acc-member@example.com"),
+					resource.TestCheckResourceAttr("quismon_team_member.test", "role", "viewer"),
+					resource.TestCheckResourceAttr("quismon_team_member.test", "status", "invited"),
+					resource.TestCheckResourceAttr("quismon_team.test", "name", "SRE"),
+					resource.TestCheckResourceAttr("quismon_team.test", "member_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("quismon_team.test", "member_ids.0", "quismon_team_member.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quismon_team_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "quismon_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Role change is applied in place
+			{
+				Config: testAccTeamConfig("editor"),
+				Check:  resource.TestCheckResourceAttr("quismon_team_member.test", "role", "editor"),
+			},
+		},
+	})
+}
+
+func TestAccTeamMemberResource_InvalidRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTeamConfig("superuser"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccTeamConfig(role string) string {
+	return fmt.Sprintf(`
+resource "quismon_team_member" "test" {
+  email = "tf-acc-member@example.com"
+  role  = %[1]q
+}
+
+resource "quismon_team" "test" {
+  name        = "SRE"
+  description = "Site reliability engineering"
+  member_ids  = [quismon_team_member.test.id]
+}
+`, role)
+}
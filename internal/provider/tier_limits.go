@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+// checkPlanLimits validates a planned check against the organization's tier limits
+// so that problems surface at plan time instead of midway through an apply.
+// Unknown values should be passed as zero values, which skips the related check.
+//
+// isCreate is true when the plan creates a new check (and therefore consumes quota).
+// The quota is compared per check against the usage fetched at configure time:
+// ModifyPlan cannot see the other checks in the same plan, so a plan that creates
+// several checks while under quota is not flagged and may still fail at apply.
+func checkPlanLimits(org *client.Organization, checkType string, intervalSeconds int64, regionCount int, enabled bool, isCreate bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if org == nil {
+		return diags
+	}
+	limits := org.Limits
+
+	if checkType != "" && !limits.AllowsCheckType(checkType) {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Check Type Not Available on Tier",
+			fmt.Sprintf("Check type %q is not available on the %q tier. Upgrade the organization or choose another check type.", checkType, org.Tier),
+		)
+	}
+
+	if intervalSeconds > 0 && limits.MinIntervalSeconds > 0 && intervalSeconds < int64(limits.MinIntervalSeconds) {
+		diags.AddAttributeError(
+			path.Root("interval_seconds"),
+			"Check Interval Below Tier Minimum",
+			fmt.Sprintf("interval_seconds = %d is below the minimum of %d seconds allowed on the %q tier.",
+				intervalSeconds, limits.MinIntervalSeconds, org.Tier),
+		)
+	}
+
+	if regionCount > 0 && limits.MaxRegionsPerCheck > 0 && regionCount > limits.MaxRegionsPerCheck {
+		diags.AddAttributeError(
+			path.Root("regions"),
+			"Too Many Regions for Tier",
+			fmt.Sprintf("%d regions are configured but the %q tier allows at most %d regions per check.",
+				regionCount, org.Tier, limits.MaxRegionsPerCheck),
+		)
+	}
+
+	if isCreate && limits.MaxChecks > 0 && org.Usage.CheckCount >= limits.MaxChecks {
+		// A warning rather than an error: checks destroyed in the same apply free up quota.
+		// Checks created by the same plan are not counted, see above.
+		diags.AddWarning(
+			"Check Quota May Be Exceeded",
+			fmt.Sprintf("The organization already has %d of %d checks allowed on the %q tier. "+
+				"Creating this check will fail unless other checks are removed first.",
+				org.Usage.CheckCount, limits.MaxChecks, org.Tier),
+		)
+	}
+
+	if enabled && intervalSeconds > 0 && regionCount > 0 && limits.ChecksPerHour > 0 {
+		runsPerHour := int(3600/intervalSeconds) * regionCount
+		if runsPerHour > limits.ChecksPerHour {
+			diags.AddWarning(
+				"Check Frequency Exceeds Tier Allowance",
+				fmt.Sprintf("This check runs about %d times per hour (%d regions every %d seconds), but the %q tier allows %d check runs per hour. "+
+					"Runs beyond the allowance will be throttled. Increase interval_seconds, reduce regions, or verify your email/upgrade.",
+					runsPerHour, regionCount, intervalSeconds, org.Tier, limits.ChecksPerHour),
+			)
+		}
+	}
+
+	return diags
+}
+
+// checkPlanFeature returns an error if the organization's tier lacks a paid feature.
+func checkPlanFeature(org *client.Organization, feature, featureName string, attrPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if org == nil || org.Limits.HasFeature(feature) {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		attrPath,
+		"Feature Not Available on Tier",
+		fmt.Sprintf("%s is not available on the %q tier. Upgrade to a 'paid' or 'enterprise' subscription to use it.", featureName, org.Tier),
+	)
+	return diags
+}
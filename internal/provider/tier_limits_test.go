@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/quismon/terraform-provider-quismon/internal/client"
+)
+
+func testTierOrganization() *client.Organization {
+	return &client.Organization{
+		Tier: "free",
+		Limits: client.OrganizationLimits{
+			MaxChecks:          10,
+			MinIntervalSeconds: 300,
+			MaxRegionsPerCheck: 2,
+			ChecksPerHour:      20,
+			AllowedCheckTypes:  []string{"http", "https", "tcp", "ping"},
+		},
+		Usage: client.OrganizationUsage{CheckCount: 3},
+	}
+}
+
+func TestCheckPlanLimits_NoOrganization(t *testing.T) {
+	diags := checkPlanLimits(nil, "throughput", 10, 30, true, true)
+	if diags.HasError() || diags.WarningsCount() > 0 {
+		t.Errorf("expected no diagnostics without organization limits, got: %v", diags)
+	}
+}
+
+func TestCheckPlanLimits_WithinLimits(t *testing.T) {
+	diags := checkPlanLimits(testTierOrganization(), "https", 600, 1, true, true)
+	if diags.HasError() || diags.WarningsCount() > 0 {
+		t.Errorf("expected no diagnostics, got: %v", diags)
+	}
+}
+
+func TestCheckPlanLimits_Violations(t *testing.T) {
+	testCases := []struct {
+		name      string
+		checkType string
+		interval  int64
+		regions   int
+		wantError string
+	}{
+		{"interval below minimum", "https", 60, 1, "Check Interval Below Tier Minimum"},
+		{"too many regions", "https", 600, 3, "Too Many Regions for Tier"},
+		{"check type not on tier", "throughput", 600, 1, "Check Type Not Available on Tier"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := checkPlanLimits(testTierOrganization(), tc.checkType, tc.interval, tc.regions, true, false)
+			if !diags.HasError() {
+				t.Fatalf("expected an error")
+			}
+			if summary := diags.Errors()[0].Summary(); summary != tc.wantError {
+				t.Errorf("expected %q, got %q", tc.wantError, summary)
+			}
+		})
+	}
+}
+
+func TestCheckPlanLimits_UnknownValuesSkipped(t *testing.T) {
+	diags := checkPlanLimits(testTierOrganization(), "", 0, 0, true, false)
+	if diags.HasError() || diags.WarningsCount() > 0 {
+		t.Errorf("expected unknown values to be skipped, got: %v", diags)
+	}
+}
+
+func TestCheckPlanLimits_QuotaWarningOnlyOnCreate(t *testing.T) {
+	org := testTierOrganization()
+	org.Usage.CheckCount = 10
+
+	if diags := checkPlanLimits(org, "https", 600, 1, true, false); diags.WarningsCount() != 0 {
+		t.Errorf("expected no quota warning on update, got: %v", diags)
+	}
+
+	diags := checkPlanLimits(org, "https", 600, 1, true, true)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected exactly one warning on create, got: %v", diags)
+	}
+	if !strings.Contains(diags.Warnings()[0].Detail(), "10 of 10") {
+		t.Errorf("expected warning to mention usage, got: %s", diags.Warnings()[0].Detail())
+	}
+}
+
+func TestCheckPlanLimits_HourlyAllowance(t *testing.T) {
+	// 2 regions every 300s = 24 runs/hour, above the 20/hour allowance
+	diags := checkPlanLimits(testTierOrganization(), "https", 300, 2, true, false)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected one frequency warning, got: %v", diags)
+	}
+
+	// Disabled checks don't run, so they don't count against the allowance
+	diags = checkPlanLimits(testTierOrganization(), "https", 300, 2, false, false)
+	if diags.WarningsCount() != 0 {
+		t.Errorf("expected no warning for disabled check, got: %v", diags)
+	}
+}
+
+func TestCheckPlanFeature(t *testing.T) {
+	org := testTierOrganization()
+	if diags := checkPlanFeature(org, "otlp", "OTLP metrics export", path.Root("enabled")); !diags.HasError() {
+		t.Error("expected an error when the tier lacks the feature")
+	}
+
+	org.Limits.Features = []string{"otlp"}
+	if diags := checkPlanFeature(org, "otlp", "OTLP metrics export", path.Root("enabled")); diags.HasError() {
+		t.Errorf("expected no error when the tier has the feature, got: %v", diags)
+	}
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"time"
+	// Embed the IANA time zone database so validation does not depend on the host
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// timeZoneValidator validates that a string attribute holds an IANA time zone
+// name, e.g. Europe/Berlin or UTC.
+type timeZoneValidator struct{}
+
+// Description returns a human-readable description of the validator.
+func (v timeZoneValidator) Description(_ context.Context) string {
+	return "value must be an IANA time zone name (e.g. Europe/Berlin)"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v timeZoneValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be an IANA time zone name (e.g. `Europe/Berlin`)"
+}
+
+// ValidateString implements the validator interface.
+func (v timeZoneValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// time.LoadLocation accepts "" and "Local", which are meaningless to the API
+	value := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time Zone",
+			"Expected an IANA time zone name (e.g. Europe/Berlin, America/New_York or UTC), got: "+value,
+		)
+	}
+}
+
+// TimeZone returns a validator which ensures a string is an IANA time zone name.
+func TimeZone() validator.String {
+	return timeZoneValidator{}
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// optionalString converts an API string into a Terraform value, mapping "" to null.
+func optionalString(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+// secretHashChanged reports whether a write-only secret was changed outside
+// Terraform: the hash the API returns for it differs from the one in state.
+// There is nothing to compare against before the first apply or after import.
+func secretHashChanged(previous types.String, current string) bool {
+	return !previous.IsNull() && !previous.IsUnknown() && previous.ValueString() != current
+}
+
+// optionalInt64 converts an API integer into a Terraform value, mapping 0 to null.
+func optionalInt64(v int) types.Int64 {
+	if v == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(v))
+}